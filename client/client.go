@@ -15,6 +15,7 @@ import (
 	"github.com/sgielen/rufs/client/content"
 	"github.com/sgielen/rufs/client/fuse"
 	"github.com/sgielen/rufs/client/metrics"
+	"github.com/sgielen/rufs/client/ratelimit"
 	"github.com/sgielen/rufs/client/shares"
 	"github.com/sgielen/rufs/client/systray"
 	"github.com/sgielen/rufs/client/vfs"
@@ -55,6 +56,12 @@ func main() {
 	vfs.InitCache(*readdirCacheTarget)
 	metrics.Init()
 	shares.Init()
+	if err := ratelimit.ReloadConfig(); err != nil {
+		log.Fatalf("Invalid upload limits: %v", err)
+	}
+	if err := content.ReloadConfig(); err != nil {
+		log.Fatalf("Invalid audit log settings: %v", err)
+	}
 
 	circles, err := config.LoadAllCerts()
 	if err != nil {
@@ -146,12 +153,19 @@ func onSettings() {
 
 func connectToCircles(circles map[string]*security.KeyPair) {
 	ctx := context.Background()
+	connectivity.HandleDiscoveryAddresses = func(circle string, addrs []string) {
+		if err := config.SetDiscoveryAddressesAndStore(circle, addrs); err != nil {
+			log.Printf("Failed to store discovery addresses of %s: %v", circle, err)
+		}
+	}
 	for circle, kp := range circles {
 		circle := circle
 		kp := kp
 		go func() {
 			// connectivity.ConnectToCircle returns nil if we already connected to a circle.
-			if err := connectivity.ConnectToCircle(ctx, circle, common.SplitMaybeEmpty(*flag_endp, ","), *port, kp); err != nil {
+			cfg, _ := config.GetCircle(circle)
+			opts := connectivity.CircleOptions{Tunnel: cfg.Tunnel, Proxy: cfg.Proxy, DiscoveryAddresses: cfg.DiscoveryAddresses}
+			if err := connectivity.ConnectToCircle(ctx, circle, opts, common.SplitMaybeEmpty(*flag_endp, ","), *port, kp); err != nil {
 				log.Fatalf("Failed to connect to circle %q: %v", circle, err)
 			}
 			metrics.SetClientStartTimeSeconds([]string{circle}, time.Now())
@@ -165,6 +179,12 @@ func ReloadConfig() {
 	if err := shares.ReloadConfig(); err != nil {
 		log.Fatalf("Failed to reload shares: %v", err)
 	}
+	if err := ratelimit.ReloadConfig(); err != nil {
+		log.Fatalf("Invalid upload limits: %v", err)
+	}
+	if err := content.ReloadConfig(); err != nil {
+		log.Fatalf("Invalid audit log settings: %v", err)
+	}
 	circles, err := config.LoadAllCerts()
 	if err != nil {
 		log.Fatalf("Failed to read certificates: %v", err)
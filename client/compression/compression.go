@@ -0,0 +1,76 @@
+// Package compression compresses file data sent to peers. Every chunk is compressed on its own, and sent uncompressed
+// if that doesn't make it smaller. Peers advertise which algorithms they can decompress, so peers that don't know
+// about compression get uncompressed data.
+package compression
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/klauspost/compress/zstd"
+	pb "github.com/sgielen/rufs/proto"
+)
+
+var (
+	enabled = flag.Bool("compression", true, "Compress file data sent to peers that support it")
+)
+
+// maxChunkSize is the largest decompressed chunk we accept. Senders send chunks of 8KB.
+const maxChunkSize = 1 << 20
+
+var (
+	encoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest), zstd.WithEncoderConcurrency(1))
+	decoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0), zstd.WithDecoderMaxMemory(maxChunkSize))
+)
+
+// Accepted returns the algorithms we can decompress, to be sent to peers.
+func Accepted() []pb.Compression {
+	if !*enabled {
+		return nil
+	}
+	return []pb.Compression{pb.Compression_ZSTD}
+}
+
+// Pick returns the algorithm to send with to a peer that accepts the given algorithms.
+func Pick(accepted []pb.Compression) pb.Compression {
+	if !*enabled {
+		return pb.Compression_UNCOMPRESSED
+	}
+	for _, c := range accepted {
+		if c == pb.Compression_ZSTD {
+			return c
+		}
+	}
+	return pb.Compression_UNCOMPRESSED
+}
+
+// Compress compresses data with c. If that doesn't make it smaller, data is returned as is with UNCOMPRESSED.
+func Compress(c pb.Compression, data []byte) ([]byte, pb.Compression) {
+	if c != pb.Compression_ZSTD || len(data) == 0 {
+		return data, pb.Compression_UNCOMPRESSED
+	}
+	ret := encoder.EncodeAll(data, make([]byte, 0, len(data)))
+	if len(ret) >= len(data) {
+		return data, pb.Compression_UNCOMPRESSED
+	}
+	return ret, c
+}
+
+// Decompress returns data decompressed with c.
+func Decompress(c pb.Compression, data []byte) ([]byte, error) {
+	switch c {
+	case pb.Compression_UNCOMPRESSED:
+		return data, nil
+	case pb.Compression_ZSTD:
+		ret, err := decoder.DecodeAll(data, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress chunk: %v", err)
+		}
+		if len(ret) > maxChunkSize {
+			return nil, fmt.Errorf("decompressed chunk is too large (%d bytes)", len(ret))
+		}
+		return ret, nil
+	default:
+		return nil, fmt.Errorf("unknown compression %s", c)
+	}
+}
@@ -0,0 +1,52 @@
+package compression
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	pb "github.com/sgielen/rufs/proto"
+)
+
+func TestCompress(t *testing.T) {
+	text := bytes.Repeat([]byte("2020-01-01 12:00:00 INFO something happened\n"), 200)
+	random := make([]byte, 8192)
+	rand.New(rand.NewSource(1)).Read(random)
+
+	for _, tc := range []struct {
+		name string
+		c    pb.Compression
+		data []byte
+		want pb.Compression
+	}{
+		{"text", pb.Compression_ZSTD, text, pb.Compression_ZSTD},
+		{"random", pb.Compression_ZSTD, random, pb.Compression_UNCOMPRESSED},
+		{"empty", pb.Compression_ZSTD, nil, pb.Compression_UNCOMPRESSED},
+		{"uncompressed", pb.Compression_UNCOMPRESSED, text, pb.Compression_UNCOMPRESSED},
+	} {
+		data, c := Compress(tc.c, tc.data)
+		if c != tc.want {
+			t.Errorf("%s: Compress() used %s; want %s", tc.name, c, tc.want)
+		}
+		if c == pb.Compression_ZSTD && len(data) >= len(tc.data) {
+			t.Errorf("%s: Compress() returned %d bytes for %d bytes of input", tc.name, len(data), len(tc.data))
+		}
+		got, err := Decompress(c, data)
+		if err != nil {
+			t.Fatalf("%s: Decompress() failed: %v", tc.name, err)
+		}
+		if !bytes.Equal(got, tc.data) {
+			t.Errorf("%s: Decompress(Compress()) doesn't return the input", tc.name)
+		}
+	}
+
+	if _, err := Decompress(pb.Compression_ZSTD, []byte("garbage")); err == nil {
+		t.Errorf("Decompress() of garbage succeeded")
+	}
+	if c := Pick(nil); c != pb.Compression_UNCOMPRESSED {
+		t.Errorf("Pick(nil) = %s; want UNCOMPRESSED", c)
+	}
+	if c := Pick(Accepted()); c != pb.Compression_ZSTD {
+		t.Errorf("Pick(Accepted()) = %s; want ZSTD", c)
+	}
+}
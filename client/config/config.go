@@ -8,6 +8,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 
@@ -95,6 +96,11 @@ func PKIFiles(circle string) (ca, crt, key string) {
 	return filepath.Join(configDir, "pki", circle, "ca.crt"), filepath.Join(configDir, "pki", circle, "user.crt"), filepath.Join(configDir, "pki", circle, "user.key")
 }
 
+func AuditLogFile() string {
+	assertResolved()
+	return filepath.Join(configDir, "audit.log")
+}
+
 func LoadCerts(circle string) (*security.KeyPair, error) {
 	caf, crtf, keyf := PKIFiles(circle)
 	ca, err := readFile(caf)
@@ -136,17 +142,71 @@ type Share struct {
 	Local   string
 	Remote  string
 	Writers []string
+	// Readers and ReaderGroups restrict who can see this share. They list users (which includes all their devices)
+	// or specific devices. If both are empty, everyone in the circle can read the share. Writers can always read it.
+	Readers      []string `yaml:"readers,omitempty"`
+	ReaderGroups []string `yaml:"reader_groups,omitempty"`
+	// WriterGroups are groups whose members are Writers.
+	WriterGroups []string `yaml:"writer_groups,omitempty"`
+	// Exclude are glob patterns of files to hide from peers, in addition to those in the .rufsignore file in the share.
+	// Patterns without a slash match names anywhere in the share; others match paths from the root of the share.
+	Exclude []string `yaml:"exclude,omitempty"`
+	// HideDotfiles hides files and directories whose name starts with a dot.
+	HideDotfiles bool `yaml:"hide_dotfiles,omitempty"`
+	// Symlinks is what to do with symbolic links: follow_within_share (the default), follow, expose or hide.
+	Symlinks string `yaml:"symlinks,omitempty"`
+	// Xattrs shares the extended attributes of files (on Linux only those in the user namespace).
+	Xattrs bool `yaml:"xattrs,omitempty"`
 }
 
 type Circle struct {
 	Name          string
 	Shares        []Share
 	DirectIOPeers []string `yaml:"directio_peers"`
+	// Groups are named lists of users or devices, for use in Share.ReaderGroups and Share.WriterGroups. They override
+	// groups with the same name from the discovery server.
+	Groups map[string][]string `yaml:"groups,omitempty"`
+	// Tunnel is a ws:// or wss:// URL to reach the discovery server through, for networks that only allow HTTP(S).
+	Tunnel string `yaml:"tunnel,omitempty"`
+	// Proxy overrides --proxy for this circle. "direct" disables the proxy.
+	Proxy string `yaml:"proxy,omitempty"`
+	// DiscoveryAddresses are the addresses (host:port) of the discovery server. If empty, the circle name is used as
+	// the address. The discovery server can send an updated list.
+	DiscoveryAddresses []string `yaml:"discovery_addresses,omitempty"`
+	// UploadLimit limits how fast we send data to peers in this circle, in bytes per second (like "1MB").
+	UploadLimit string `yaml:"upload_limit,omitempty"`
+	// PeerUploadLimits limits how fast we send data to specific devices or users. All devices of a user share the
+	// user's limit.
+	PeerUploadLimits map[string]string `yaml:"peer_upload_limits,omitempty"`
+}
+
+// UploadWindow overrides Config.UploadLimit between From and To ("15:04").
+type UploadWindow struct {
+	From  string
+	To    string
+	Limit string
+}
+
+// AuditLog configures the log of what peers read from our shares.
+type AuditLog struct {
+	Disabled bool `yaml:"disabled,omitempty"`
+	// MaxSizeMB is the size after which the log is rotated. Defaults to 10.
+	MaxSizeMB int `yaml:"max_size_mb,omitempty"`
+	// MaxFiles is how many rotated logs are kept. Defaults to 10.
+	MaxFiles int `yaml:"max_files,omitempty"`
+	// MaxAgeDays is how long rotated logs are kept. Zero means until there are more than MaxFiles.
+	MaxAgeDays int `yaml:"max_age_days,omitempty"`
 }
 
 type Config struct {
 	Circles    []Circle
 	Mountpoint string
+	// UploadLimit limits how fast we send data to all peers together, in bytes per second (like "1MB").
+	UploadLimit    string         `yaml:"upload_limit,omitempty"`
+	UploadSchedule []UploadWindow `yaml:"upload_schedule,omitempty"`
+	// DownloadLimit limits how fast we download from all peers together. Data a read() is waiting for goes first.
+	DownloadLimit string   `yaml:"download_limit,omitempty"`
+	AuditLog      AuditLog `yaml:"audit_log,omitempty"`
 }
 
 func parseConfig(data []byte) (*Config, error) {
@@ -242,6 +302,28 @@ func AddShareAndStore(circle, share, local string) error {
 	return fmt.Errorf("unknown circle %q", circle)
 }
 
+// SetDiscoveryAddressesAndStore replaces the discovery addresses of a circle. It doesn't touch the config file if they
+// didn't change.
+func SetDiscoveryAddressesAndStore(circle string, addrs []string) error {
+	assertParsed()
+	mtx.Lock()
+	defer mtx.Unlock()
+	newCfg, err := currentOrDefaultConfig()
+	if err != nil {
+		return err
+	}
+	for i, c := range newCfg.Circles {
+		if c.Name == circle {
+			if slices.Equal(c.DiscoveryAddresses, addrs) {
+				return nil
+			}
+			newCfg.Circles[i].DiscoveryAddresses = addrs
+			return writeNewConfig(newCfg)
+		}
+	}
+	return fmt.Errorf("unknown circle %q", circle)
+}
+
 func SetMountpointAndStore(mp string) error {
 	assertParsed()
 	mtx.Lock()
@@ -4,6 +4,8 @@ import (
 	pb "github.com/sgielen/rufs/proto"
 	"google.golang.org/grpc/balancer"
 	"google.golang.org/grpc/balancer/base"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const BalancerName string = "rufs-peer"
@@ -20,31 +22,80 @@ func (*rufsPickerBuilder) Build(info base.PickerBuildInfo) balancer.Picker {
 	// Normally, a picker considers all subconns to point to different nodes.
 	// In this case, it makes sense to balance requests over them to spread load.
 	// In our case, however, we know that they are various connections to the
-	// same node. Hence, we try to pick the most efficient connection, preferring
-	// TCP over UDP, but we only pre-pick a single subconn. If the set of ready
-	// subconns changes, this method will be invoked to pick the new best one.
-
-	// Attempt to use the first ready TCP subconn.
+	// same node. Hence, we try to pick the most efficient connection. Path
+	// quality is measured continuously (see health.go), so we pick for each RPC
+	// rather than once per set of ready subconns. That way we fail over as soon
+	// as a path degrades, even if gRPC still considers it ready.
+	if len(info.ReadySCs) == 0 {
+		// No SCs ready? Return an error picker.
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+	p := &rufsPicker{subConns: map[string]balancer.SubConn{}}
 	for subconn, sinfo := range info.ReadySCs {
-		endpointType, _ := splitGrpcAddress(sinfo.Address.Addr)
-		if endpointType == pb.Endpoint_TCP {
-			return &rufsPicker{subConn: subconn}
-		}
+		p.subConns[sinfo.Address.Addr] = subconn
 	}
+	return p
+}
 
-	// Otherwise, just use any first one.
-	for subconn, _ := range info.ReadySCs {
-		return &rufsPicker{subConn: subconn}
+type rufsPicker struct {
+	subConns map[string]balancer.SubConn
+}
+
+func (p *rufsPicker) Pick(info balancer.PickInfo) (balancer.PickResult, error) {
+	if t := probeTargetFromContext(info.Ctx); t != nil {
+		sc, ok := p.subConns[t.addr]
+		if !ok {
+			t.notReady = true
+			return balancer.PickResult{}, status.Errorf(codes.Unavailable, "path %s is not connected", t.addr)
+		}
+		return balancer.PickResult{SubConn: sc}, nil
 	}
 
-	// No SCs ready? Return an error picker.
-	return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	var best balancer.SubConn
+	var bestType pb.Endpoint_Type
+	var bestQuality pathQuality
+	for addr, subconn := range p.subConns {
+		endpointType, _ := splitGrpcAddress(addr)
+		q := getPathQuality(addr)
+		if best == nil || betterPath(endpointType, q, bestType, bestQuality) {
+			best = subconn
+			bestType = endpointType
+			bestQuality = q
+		}
+	}
+	return balancer.PickResult{SubConn: best}, nil
 }
 
-type rufsPicker struct {
-	subConn balancer.SubConn
+// endpointPreference ranks endpoint types when their quality is similar: TCP over QUIC over SCTP over WebSocket
+// tunnels over the relay.
+var endpointPreference = map[pb.Endpoint_Type]int{
+	pb.Endpoint_TCP:           5,
+	pb.Endpoint_QUIC:          4,
+	pb.Endpoint_SCTP_OVER_UDP: 3,
+	pb.Endpoint_WEBSOCKET:     2,
+	pb.Endpoint_RELAY:         1,
 }
 
-func (p *rufsPicker) Pick(balancer.PickInfo) (balancer.PickResult, error) {
-	return balancer.PickResult{SubConn: p.subConn}, nil
+// betterPath returns whether path a is better than path b. Paths that stopped answering probes are avoided, and
+// otherwise the relay is only used as a last resort. Then we prefer the path with clearly less loss, then the one with
+// clearly lower latency (such as an address on the local network), and fall back to endpointPreference.
+func betterPath(aType pb.Endpoint_Type, a pathQuality, bType pb.Endpoint_Type, b pathQuality) bool {
+	if a.healthy != b.healthy {
+		return a.healthy
+	}
+	if (aType == pb.Endpoint_RELAY) != (bType == pb.Endpoint_RELAY) {
+		return bType == pb.Endpoint_RELAY
+	}
+	if d := a.loss - b.loss; d > 0.1 || d < -0.1 {
+		return d < 0
+	}
+	if a.latency > 0 && b.latency > 0 {
+		if a.latency < b.latency*4/5 {
+			return true
+		}
+		if b.latency < a.latency*4/5 {
+			return false
+		}
+	}
+	return endpointPreference[aType] > endpointPreference[bType]
 }
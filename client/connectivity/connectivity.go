@@ -11,12 +11,15 @@ import (
 	"time"
 
 	"github.com/Jille/rpcz"
+	"github.com/ory/go-convenience/stringslice"
 	"github.com/sgielen/rufs/client/connectivity/udptransport"
+	"github.com/sgielen/rufs/client/proxy"
 	"github.com/sgielen/rufs/client/remotelogging"
 	"github.com/sgielen/rufs/common"
 	pb "github.com/sgielen/rufs/proto"
 	"github.com/sgielen/rufs/security"
 	"github.com/sgielen/rufs/version"
+	"github.com/sgielen/rufs/wstunnel"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials"
@@ -31,7 +34,9 @@ var (
 
 	HandleResolveConflictRequest    = func(ctx context.Context, req *pb.ResolveConflictRequest, circle string) {}
 	HandleActiveDownloadList        = func(ctx context.Context, req *pb.ConnectResponse_ActiveDownloadList, circle string) {}
+	HandleGroups                    = func(ctx context.Context, req *pb.ConnectResponse_GroupList, circle string) {}
 	HandleIncomingContentConnection = func(net.Conn) {}
+	HandleDiscoveryAddresses        = func(circle string, addrs []string) {}
 
 	nextManualResolverScheme int
 )
@@ -39,16 +44,45 @@ var (
 type circle struct {
 	name        string
 	client      pb.DiscoveryServiceClient
+	relayClient pb.RelayServiceClient
 	myPort      int
 	udpSocket   *udptransport.Socket
 	myEndpoints []string
 	keyPair     *security.KeyPair
+	// discoveryResolver resolves the circle to the addresses of the discovery server.
+	discoveryResolver *manual.Resolver
+	// dial makes TCP connections, through the proxy if we have one. tunnelDial is only set if we have a proxy.
+	dial       proxy.DialFunc
+	tunnelDial proxy.DialFunc
+	// proxied is set if we make outbound connections through a proxy. We probably can't use UDP or receive incoming
+	// connections then.
+	proxied bool
+	// punchUnsupported is set if the discovery server doesn't support RequestPunch.
+	punchUnsupported int32
 
 	mtx   sync.Mutex
 	peers map[string]*Peer
+	// lanPeers are the peers we've heard announce themselves on the local network.
+	lanPeers map[string]*lanPeer
+	natType  udptransport.NATType
+	// discoveryAddresses are the addresses the discovery server resolves to.
+	discoveryAddresses []string
+	// cancelConnect ends the current stream to the discovery server, which makes us reconnect.
+	cancelConnect context.CancelFunc
 }
 
-func ConnectToCircle(ctx context.Context, name string, myEndpoints []string, myPort int, kp *security.KeyPair) error {
+// CircleOptions are the per-circle settings for how we connect.
+type CircleOptions struct {
+	// Tunnel is a WebSocket URL to reach the discovery server through, or empty to connect directly.
+	Tunnel string
+	// Proxy is the proxy URL for outbound connections (see proxy.Resolve).
+	Proxy string
+	// DiscoveryAddresses are the addresses of the discovery server, in order of preference. If empty, the circle name
+	// is used.
+	DiscoveryAddresses []string
+}
+
+func ConnectToCircle(ctx context.Context, name string, opts CircleOptions, myEndpoints []string, myPort int, kp *security.KeyPair) error {
 	cmtx.Lock()
 	_, found := circles[name]
 	cmtx.Unlock()
@@ -56,39 +90,83 @@ func ConnectToCircle(ctx context.Context, name string, myEndpoints []string, myP
 		return nil
 	}
 
-	addr, port, err := net.SplitHostPort(name)
+	addrs := discoveryAddresses(opts.DiscoveryAddresses)
+	if len(addrs) == 0 {
+		addrs = discoveryAddresses([]string{name})
+	}
+
+	proxyURL := proxy.Resolve(opts.Proxy)
+	dial, err := proxy.Dialer(proxyURL)
 	if err != nil {
-		addr = name
-		port = "12000"
+		return err
+	}
+	var tunnelDial proxy.DialFunc
+	if proxyURL != "" {
+		log.Printf("Connecting to %s through proxy %s", name, proxy.Redact(proxyURL))
+		tunnelDial = dial
+	}
+
+	// Enable gRPC-over-UDP, unless all our traffic should go through a proxy.
+	var udpSocket *udptransport.Socket
+	if proxyURL == "" {
+		udpSocket, err = udptransport.New(HandleIncomingContentConnection, addrs[0])
+		if err != nil {
+			log.Printf("failed to enable gRPC-over-UDP: %v", err)
+			udpSocket = nil
+		} else if err := udpSocket.EnableQUIC(kp.TLSConfigForQUIC()); err != nil {
+			log.Printf("failed to enable gRPC-over-QUIC: %v", err)
+		}
 	}
 
-	conn, err := grpc.DialContext(ctx, net.JoinHostPort(addr, port),
+	// The circle name is the target, so that we keep verifying the discovery server against the circle CA whichever
+	// address we connect to. pick_first fails over between the addresses.
+	r := manual.NewBuilderWithScheme("rufs-discovery")
+	r.InitialState(discoveryResolverState(addrs))
+	conn, err := grpc.DialContext(ctx, r.Scheme()+":///"+name,
+		grpc.WithResolvers(r),
 		grpc.WithTransportCredentials(credentials.NewTLS(kp.TLSConfigForMasterClient())),
 		grpc.WithUnaryInterceptor(rpcz.UnaryClientInterceptor),
 		grpc.WithStreamInterceptor(rpcz.StreamClientInterceptor),
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			if opts.Tunnel != "" {
+				return wstunnel.Dial(ctx, opts.Tunnel, tunnelDial)
+			}
+			conn, err := dial(ctx, "tcp", addr)
+			if err == nil && udpSocket != nil {
+				// The stunlite server runs on the same address as the discovery server.
+				udpSocket.SetStunliteServer(addr)
+			}
+			return conn, err
+		}),
 		grpc.WithBlock())
 	if err != nil {
 		return fmt.Errorf("failed to connect to discovery server: %v", err)
 	}
 	client := pb.NewDiscoveryServiceClient(conn)
-
-	// Enable gRPC-over-UDP
-	udpSocket, err := udptransport.New(HandleIncomingContentConnection, net.JoinHostPort(addr, port))
-	if err != nil {
-		log.Printf("failed to enable gRPC-over-UDP: %v", err)
-	}
+	relayClient := pb.NewRelayServiceClient(conn)
 
 	c := &circle{
-		name:        name,
-		client:      client,
-		myPort:      myPort,
-		udpSocket:   udpSocket,
-		myEndpoints: myEndpoints,
-		keyPair:     kp,
-		peers:       map[string]*Peer{},
+		name:               name,
+		client:             client,
+		discoveryResolver:  r,
+		discoveryAddresses: addrs,
+		relayClient:        relayClient,
+		myPort:             myPort,
+		udpSocket:          udpSocket,
+		myEndpoints:        myEndpoints,
+		keyPair:            kp,
+		dial:               dial,
+		tunnelDial:         tunnelDial,
+		proxied:            proxyURL != "",
+		peers:              map[string]*Peer{},
+		lanPeers:           map[string]*lanPeer{},
 	}
 
 	go c.run(ctx)
+	go c.runRelayListener(ctx)
+	go c.runLanAnnouncer(ctx)
+	go c.runNATClassifier(ctx)
+	websocketOnce.Do(serveWebsocketTunnel)
 	cmtx.Lock()
 	circles[name] = c
 	cmtx.Unlock()
@@ -113,36 +191,68 @@ func (c *circle) connect(ctx context.Context) error {
 		for _, endpoint := range c.myEndpoints {
 			_, _, err := net.SplitHostPort(endpoint)
 			if err != nil {
-				endpoint = net.JoinHostPort(endpoint, fmt.Sprint(c.myPort))
+				endpoint = net.JoinHostPort(strings.Trim(endpoint, "[]"), fmt.Sprint(c.myPort))
 			}
 			endpoints = append(endpoints, &pb.Endpoint{
 				Type:    pb.Endpoint_TCP,
 				Address: endpoint,
 			})
 		}
-	} else {
-		// Auto-detect endpoints
+	} else if !c.proxied {
+		// Auto-detect endpoints. Through a proxy, the discovery server would see the proxy's address and we probably
+		// can't receive incoming connections anyway, so we don't announce any.
 		res, err := c.client.GetMyIP(ctx, &pb.GetMyIPRequest{})
 		if err != nil {
 			return fmt.Errorf("no ips given and failed to retrieve IP from discovery server")
 		}
-		endpoints = append(endpoints, &pb.Endpoint{
-			Type:    pb.Endpoint_TCP,
-			Address: net.JoinHostPort(res.GetIp(), fmt.Sprint(c.myPort)),
-		})
+		ips := []string{res.GetIp()}
+		// We only see one address family through the discovery server. IPv6 addresses usually aren't NATted, so
+		// announce our global IPv6 addresses too.
+		for _, ip := range globalIPv6Addresses() {
+			if !stringslice.Has(ips, ip) {
+				ips = append(ips, ip)
+			}
+		}
+		for _, ip := range ips {
+			endpoints = append(endpoints, &pb.Endpoint{
+				Type:    pb.Endpoint_TCP,
+				Address: net.JoinHostPort(ip, fmt.Sprint(c.myPort)),
+			})
+		}
 	}
 
-	// Auto-detect our gRPC-over-SCTP-over-UDP public endpoint
-	udpEndpoint, err := c.udpSocket.PerformStunlite(ctx)
-	if err != nil {
-		log.Printf("gRPC-over-UDP disabled, stunlite failed: %v", err)
-	} else {
+	endpoints = append(endpoints, websocketEndpoints(endpoints)...)
+
+	// Auto-detect our gRPC-over-SCTP-over-UDP public endpoints
+	var udpEndpoints []string
+	if c.udpSocket != nil {
+		var err error
+		udpEndpoints, err = c.udpSocket.PerformStunlite(ctx)
+		if err != nil {
+			log.Printf("gRPC-over-UDP disabled, stunlite failed: %v", err)
+		}
+	}
+	for _, udpEndpoint := range udpEndpoints {
 		endpoints = append(endpoints, &pb.Endpoint{
 			Type:    pb.Endpoint_SCTP_OVER_UDP,
 			Address: udpEndpoint,
+		}, &pb.Endpoint{
+			Type:    pb.Endpoint_QUIC,
+			Address: udpEndpoint,
 		})
 	}
 
+	if len(c.myEndpoints) == 0 {
+		// Peers on the same network might not be able to reach our public addresses (no hairpin NAT).
+		endpoints = append(endpoints, c.myLanEndpoints()...)
+	}
+
+	// As a last resort, peers can reach us through the relay of the discovery server.
+	endpoints = append(endpoints, &pb.Endpoint{
+		Type:    pb.Endpoint_RELAY,
+		Address: c.name,
+	})
+
 	// TODO: Remove this, as soon as all Discovery servers have new-endpoint support.
 	var oldEndpoints []string
 	for _, endpoint := range endpoints {
@@ -151,10 +261,18 @@ func (c *circle) connect(ctx context.Context) error {
 		}
 	}
 
-	stream, err := c.client.Connect(ctx, &pb.ConnectRequest{
-		OldEndpoints:  oldEndpoints,
-		ClientVersion: version.GetVersion(),
-		Endpoints:     endpoints,
+	sctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	c.mtx.Lock()
+	c.cancelConnect = cancel
+	natType := pb.NATType(c.natType)
+	c.mtx.Unlock()
+	stream, err := c.client.Connect(sctx, &pb.ConnectRequest{
+		OldEndpoints:           oldEndpoints,
+		ClientVersion:          version.GetVersion(),
+		Endpoints:              endpoints,
+		SupportsPeerListDeltas: true,
+		NatType:                natType,
 	}, grpc.WaitForReady(true))
 	if err != nil {
 		return fmt.Errorf("failed to subscribe to discovery server: %v", err)
@@ -162,13 +280,27 @@ func (c *circle) connect(ctx context.Context) error {
 	log.Printf("Connected to RuFS circle %s. My endpoints: %v", c.name, endpoints)
 	go runConnectivityMetrics(ctx, c.name, c.client)
 
+	var peerListVersion int64
 	for {
 		msg, err := stream.Recv()
 		if err != nil {
+			if sctx.Err() != nil && ctx.Err() == nil {
+				// We're reconnecting on purpose.
+				return nil
+			}
 			return fmt.Errorf("discovery server stream error: %v", err)
 		}
 		if msg.GetPeerList() != nil {
 			c.processPeers(ctx, msg.GetPeerList().GetPeers())
+			peerListVersion = msg.GetPeerList().GetVersion()
+		}
+		if msg.GetPeerListDelta() != nil {
+			if msg.GetPeerListDelta().GetPreviousVersion() != peerListVersion {
+				// Reconnecting gets us a new full peer list.
+				return fmt.Errorf("missed peer list update (have version %d, got delta from %d)", peerListVersion, msg.GetPeerListDelta().GetPreviousVersion())
+			}
+			c.processPeerListDelta(ctx, msg.GetPeerListDelta())
+			peerListVersion = msg.GetPeerListDelta().GetVersion()
 		}
 		if msg.GetResolveConflictRequest() != nil {
 			HandleResolveConflictRequest(ctx, msg.GetResolveConflictRequest(), c.name)
@@ -176,6 +308,15 @@ func (c *circle) connect(ctx context.Context) error {
 		if msg.GetActiveDownloads() != nil {
 			HandleActiveDownloadList(ctx, msg.GetActiveDownloads(), c.name)
 		}
+		if msg.GetPunchRequest() != nil {
+			go c.handlePunchRequest(ctx, msg.GetPunchRequest())
+		}
+		if msg.GetGroups() != nil {
+			HandleGroups(ctx, msg.GetGroups(), c.name)
+		}
+		if msg.GetDiscoveryAddresses() != nil {
+			c.updateDiscoveryAddresses(msg.GetDiscoveryAddresses().GetAddresses())
+		}
 	}
 }
 
@@ -207,10 +348,31 @@ func (c *circle) processPeers(ctx context.Context, peers []*pb.Peer) {
 	}
 }
 
+func (c *circle) processPeerListDelta(ctx context.Context, delta *pb.ConnectResponse_PeerListDelta) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	for _, pe := range delta.GetUpdatedPeers() {
+		if po, existing := c.peers[pe.GetName()]; existing {
+			po.update(pe)
+		} else {
+			c.peers[pe.GetName()] = c.newPeer(ctx, pe)
+		}
+	}
+	for _, name := range delta.GetRemovedPeers() {
+		cp, existing := c.peers[name]
+		if !existing || cp.externallyReferenced {
+			continue
+		}
+		cp.conn.Close()
+		delete(c.peers, name)
+	}
+}
+
 func (c *circle) newPeer(ctx context.Context, p *pb.Peer) *Peer {
 	nextManualResolverScheme++
 	r := manual.NewBuilderWithScheme(fmt.Sprintf("rufs-%d", nextManualResolverScheme))
-	r.InitialState(c.peerToResolverState(p))
+	state := c.peerToResolverState(p)
+	r.InitialState(state)
 
 	// If we enable keepalive on a peer that does not allow it, they will
 	// close the connection with a GOAWAY / ENHANCE_YOUR_CALM error.
@@ -255,31 +417,73 @@ func (c *circle) newPeer(ctx context.Context, p *pb.Peer) *Peer {
 	if err != nil {
 		log.Fatalf("Failed to dial peer %q: %v", r.Scheme(), err)
 	}
-	return &Peer{
-		Name:     p.GetName(),
-		circle:   c,
-		conn:     conn,
-		resolver: r,
+	po := &Peer{
+		Name:          p.GetName(),
+		circle:        c,
+		conn:          conn,
+		resolver:      r,
+		discoveryPeer: p,
+		addresses:     resolverStateAddresses(state),
 	}
+	go po.runProber()
+	return po
 }
 
 func (c *circle) myName() string {
 	return c.keyPair.CommonName()
 }
 
-func (c *circle) dialPeer(ctx context.Context, addr string) (net.Conn, error) {
+func (c *circle) dialPeer(ctx context.Context, grpcAddr string) (net.Conn, error) {
+	conn, err := c.dialEndpoint(ctx, grpcAddr)
+	if err != nil {
+		return nil, err
+	}
+	return newLatencyMeasuringConn(conn, grpcAddr), nil
+}
+
+func (c *circle) dialEndpoint(ctx context.Context, addr string) (net.Conn, error) {
 	endpointType, addr := splitGrpcAddress(addr)
 	switch endpointType {
 	case pb.Endpoint_SCTP_OVER_UDP:
 		return c.udpSocket.DialContext(ctx, addr)
+	case pb.Endpoint_QUIC:
+		return c.udpSocket.DialQUIC(ctx, addr)
 	case pb.Endpoint_TCP:
-		var d net.Dialer
-		return d.DialContext(ctx, "tcp", addr)
+		return c.dial(ctx, "tcp", addr)
+	case pb.Endpoint_RELAY:
+		return c.dialRelay(ctx, addr)
+	case pb.Endpoint_WEBSOCKET:
+		return wstunnel.Dial(ctx, addr, c.tunnelDial)
 	default:
 		return nil, fmt.Errorf("internal error: unknown endpoint type in address: %s", addr)
 	}
 }
 
+// globalIPv6Addresses returns the global unicast IPv6 addresses of this machine, excluding unique local addresses.
+func globalIPv6Addresses() []string {
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return nil
+	}
+	var ret []string
+	for _, a := range addrs {
+		ipnet, ok := a.(*net.IPNet)
+		if !ok {
+			continue
+		}
+		ip := ipnet.IP
+		if ip.To4() != nil || !ip.IsGlobalUnicast() {
+			continue
+		}
+		if ip[0]&0xfe == 0xfc {
+			// Unique local address (fc00::/7)
+			continue
+		}
+		ret = append(ret, ip.String())
+	}
+	return ret
+}
+
 func joinGrpcAddress(t pb.Endpoint_Type, addr string) string {
 	return t.String() + ":" + addr
 }
@@ -292,6 +496,7 @@ func splitGrpcAddress(addr string) (pb.Endpoint_Type, string) {
 	return pb.Endpoint_UNKNOWN_TYPE, sp[1]
 }
 
+// peerToResolverState returns the addresses we can use to reach p. c.mtx must be held.
 func (c *circle) peerToResolverState(p *pb.Peer) resolver.State {
 	var s resolver.State
 
@@ -304,12 +509,37 @@ func (c *circle) peerToResolverState(p *pb.Peer) resolver.State {
 		return s
 	}
 
-	for _, e := range p.GetEndpoints() {
+	endpoints := p.GetEndpoints()
+	if lp, ok := c.lanPeers[p.GetName()]; ok && lp.expiry.After(time.Now()) {
+		endpoints = append(append([]*pb.Endpoint{}, lp.endpoints...), endpoints...)
+	}
+	seen := map[string]bool{}
+	for _, e := range endpoints {
 		if _, known := pb.Endpoint_Type_name[int32(e.Type)]; !known || e.Type == 0 {
 			continue
 		}
+		if c.udpSocket == nil && (e.Type == pb.Endpoint_SCTP_OVER_UDP || e.Type == pb.Endpoint_QUIC) {
+			continue
+		}
+		if e.Type == pb.Endpoint_RELAY {
+			// We only know how to use the relay of our own discovery server. The relay needs the peer name rather
+			// than an address.
+			if e.GetAddress() != c.name {
+				continue
+			}
+			s.Addresses = append(s.Addresses, resolver.Address{
+				Addr:       joinGrpcAddress(e.Type, p.GetName()),
+				ServerName: p.GetName(),
+			})
+			continue
+		}
+		addr := joinGrpcAddress(e.Type, e.GetAddress())
+		if seen[addr] {
+			continue
+		}
+		seen[addr] = true
 		s.Addresses = append(s.Addresses, resolver.Address{
-			Addr:       joinGrpcAddress(e.Type, e.GetAddress()),
+			Addr:       addr,
 			ServerName: p.GetName(),
 		})
 	}
@@ -332,13 +562,39 @@ type Peer struct {
 	circle   *circle
 	conn     *grpc.ClientConn
 	resolver *manual.Resolver
+	// discoveryPeer is the last information we got from the discovery server about this peer.
+	discoveryPeer *pb.Peer
+	// addresses are the gRPC addresses we're connecting to this peer over.
+	addresses []string
+	// When we may request hole punching to this peer next, and how long we wait after that.
+	nextPunch    time.Time
+	punchBackoff time.Duration
 
 	// Whether this peer was fetched by AcquirePeer and may not be garbage collected.
 	externallyReferenced bool
 }
 
 func (p *Peer) update(pe *pb.Peer) {
-	p.resolver.UpdateState(p.circle.peerToResolverState(pe))
+	p.discoveryPeer = pe
+	state := p.circle.peerToResolverState(pe)
+	addresses := resolverStateAddresses(state)
+	var removed []string
+	for _, a := range p.addresses {
+		if !stringslice.Has(addresses, a) {
+			removed = append(removed, a)
+		}
+	}
+	forgetPaths(removed)
+	p.addresses = addresses
+	p.resolver.UpdateState(state)
+}
+
+func resolverStateAddresses(s resolver.State) []string {
+	ret := make([]string, len(s.Addresses))
+	for i, a := range s.Addresses {
+		ret[i] = a.Addr
+	}
+	return ret
 }
 
 func (p *Peer) ContentServiceClient() pb.ContentServiceClient {
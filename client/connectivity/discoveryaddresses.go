@@ -0,0 +1,51 @@
+package connectivity
+
+import (
+	"log"
+	"net"
+	"slices"
+
+	"google.golang.org/grpc/resolver"
+)
+
+// discoveryAddresses adds the default port to addresses that don't have one, and drops empty ones.
+func discoveryAddresses(addrs []string) []string {
+	var ret []string
+	for _, a := range addrs {
+		if a == "" {
+			continue
+		}
+		if _, _, err := net.SplitHostPort(a); err != nil {
+			a = net.JoinHostPort(a, "12000")
+		}
+		ret = append(ret, a)
+	}
+	return ret
+}
+
+func discoveryResolverState(addrs []string) resolver.State {
+	var state resolver.State
+	for _, a := range addrs {
+		state.Addresses = append(state.Addresses, resolver.Address{Addr: a})
+	}
+	return state
+}
+
+// updateDiscoveryAddresses switches to the addresses the discovery server sent us, and stores them for the next time
+// we start.
+func (c *circle) updateDiscoveryAddresses(addrs []string) {
+	addrs = discoveryAddresses(addrs)
+	if len(addrs) == 0 {
+		return
+	}
+	c.mtx.Lock()
+	changed := !slices.Equal(c.discoveryAddresses, addrs)
+	c.discoveryAddresses = addrs
+	c.mtx.Unlock()
+	if !changed {
+		return
+	}
+	log.Printf("Discovery server of %s is reachable at %v", c.name, addrs)
+	c.discoveryResolver.UpdateState(discoveryResolverState(addrs))
+	HandleDiscoveryAddresses(c.name, addrs)
+}
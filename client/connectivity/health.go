@@ -0,0 +1,261 @@
+package connectivity
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/sgielen/rufs/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	probeInterval = 5 * time.Second
+	probeTimeout  = 2 * time.Second
+	// probeWindow is the number of recent probes we calculate the loss over.
+	probeWindow = 20
+	// unhealthyAfter is the number of consecutive failed probes after which we stop using a path if there is another.
+	unhealthyAfter = 3
+)
+
+var (
+	pathMtx sync.Mutex
+	// paths contains the measurements for each gRPC address we connect to peers over.
+	paths = map[string]*pathStats{}
+)
+
+type pathStats struct {
+	peer string
+	// handshakeLatency is the round trip time we measured during the handshake of the last connection.
+	handshakeLatency time.Duration
+	// rtt is the smoothed round trip time of the probes.
+	rtt                 time.Duration
+	results             []bool
+	consecutiveFailures int
+	ready               bool
+}
+
+// pathQuality is what the picker bases its decisions on.
+type pathQuality struct {
+	latency time.Duration
+	loss    float64
+	healthy bool
+}
+
+func getPathStats(addr string) *pathStats {
+	ps, ok := paths[addr]
+	if !ok {
+		ps = &pathStats{}
+		paths[addr] = ps
+	}
+	return ps
+}
+
+func recordHandshakeLatency(addr string, d time.Duration) {
+	pathMtx.Lock()
+	defer pathMtx.Unlock()
+	getPathStats(addr).handshakeLatency = d
+}
+
+func recordProbe(peer, addr string, rtt time.Duration, ok bool) {
+	pathMtx.Lock()
+	defer pathMtx.Unlock()
+	ps := getPathStats(addr)
+	ps.peer = peer
+	ps.ready = true
+	ps.results = append(ps.results, ok)
+	if len(ps.results) > probeWindow {
+		ps.results = ps.results[len(ps.results)-probeWindow:]
+	}
+	if !ok {
+		ps.consecutiveFailures++
+		return
+	}
+	ps.consecutiveFailures = 0
+	if ps.rtt == 0 {
+		ps.rtt = rtt
+	} else {
+		ps.rtt = (7*ps.rtt + rtt) / 8
+	}
+}
+
+func recordNotReady(peer, addr string) {
+	pathMtx.Lock()
+	defer pathMtx.Unlock()
+	ps := getPathStats(addr)
+	ps.peer = peer
+	ps.ready = false
+}
+
+func forgetPaths(addrs []string) {
+	pathMtx.Lock()
+	defer pathMtx.Unlock()
+	for _, a := range addrs {
+		delete(paths, a)
+	}
+}
+
+func (ps *pathStats) quality() pathQuality {
+	q := pathQuality{
+		latency: ps.rtt,
+		healthy: ps.consecutiveFailures < unhealthyAfter,
+	}
+	if q.latency == 0 {
+		q.latency = ps.handshakeLatency
+	}
+	if len(ps.results) > 0 {
+		failed := 0
+		for _, ok := range ps.results {
+			if !ok {
+				failed++
+			}
+		}
+		q.loss = float64(failed) / float64(len(ps.results))
+	}
+	return q
+}
+
+func getPathQuality(addr string) pathQuality {
+	pathMtx.Lock()
+	defer pathMtx.Unlock()
+	ps, ok := paths[addr]
+	if !ok {
+		return pathQuality{healthy: true}
+	}
+	return ps.quality()
+}
+
+// directPathState returns whether any of the direct (non-relay) paths in addrs is usable, and whether all of them
+// have been probed at least once.
+func directPathState(addrs []string) (ready, probed bool) {
+	pathMtx.Lock()
+	defer pathMtx.Unlock()
+	probed = true
+	for _, addr := range addrs {
+		if t, _ := splitGrpcAddress(addr); t == pb.Endpoint_RELAY {
+			continue
+		}
+		ps, ok := paths[addr]
+		if !ok || ps.peer == "" {
+			probed = false
+			continue
+		}
+		if ps.ready && ps.quality().healthy {
+			ready = true
+		}
+	}
+	return ready, probed
+}
+
+type probeTargetKey struct{}
+
+// probeTarget tells the picker to use a specific subconn, so we can measure each path separately.
+type probeTarget struct {
+	addr string
+	// notReady is set by the picker if the subconn for addr isn't connected.
+	notReady bool
+}
+
+func probeTargetFromContext(ctx context.Context) *probeTarget {
+	t, _ := ctx.Value(probeTargetKey{}).(*probeTarget)
+	return t
+}
+
+// runProber periodically measures all paths to the peer until the peer is closed.
+func (p *Peer) runProber() {
+	for {
+		time.Sleep(probeInterval)
+		p.circle.mtx.Lock()
+		addrs := p.addresses
+		p.circle.mtx.Unlock()
+		if p.conn.GetState() == connectivity.Shutdown {
+			forgetPaths(addrs)
+			return
+		}
+		p.maybeRequestPunch(addrs)
+		for _, addr := range addrs {
+			go p.probe(addr)
+		}
+	}
+}
+
+func (p *Peer) probe(addr string) {
+	t := &probeTarget{addr: addr}
+	ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), probeTargetKey{}, t), probeTimeout)
+	defer cancel()
+	start := time.Now()
+	_, err := p.ContentServiceClient().Ping(ctx, &pb.PingRequest{})
+	if t.notReady {
+		recordNotReady(p.Name, addr)
+		return
+	}
+	switch status.Code(err) {
+	case codes.OK, codes.Unimplemented:
+		// Older peers don't implement Ping, but the error still took a round trip.
+		recordProbe(p.Name, addr, time.Since(start), true)
+	default:
+		recordProbe(p.Name, addr, 0, false)
+	}
+}
+
+type PathInfo struct {
+	Peer      string  `json:"peer"`
+	Type      string  `json:"type"`
+	Address   string  `json:"address"`
+	Ready     bool    `json:"ready"`
+	RTTMillis float64 `json:"rtt_ms"`
+	Loss      float64 `json:"loss"`
+	Healthy   bool    `json:"healthy"`
+	// Preferred is set for the path new RPCs to the peer are sent over.
+	Preferred bool `json:"preferred"`
+}
+
+// Paths returns the measurements of all paths to all peers.
+func Paths() []PathInfo {
+	pathMtx.Lock()
+	defer pathMtx.Unlock()
+	type bestPath struct {
+		idx     int
+		t       pb.Endpoint_Type
+		quality pathQuality
+	}
+	var ret []PathInfo
+	best := map[string]bestPath{}
+	for addr, ps := range paths {
+		if ps.peer == "" {
+			continue
+		}
+		t, a := splitGrpcAddress(addr)
+		q := ps.quality()
+		if ps.ready {
+			if b, ok := best[ps.peer]; !ok || betterPath(t, q, b.t, b.quality) {
+				best[ps.peer] = bestPath{len(ret), t, q}
+			}
+		}
+		ret = append(ret, PathInfo{
+			Peer:      ps.peer,
+			Type:      t.String(),
+			Address:   a,
+			Ready:     ps.ready,
+			RTTMillis: float64(q.latency) / float64(time.Millisecond),
+			Loss:      q.loss,
+			Healthy:   q.healthy,
+		})
+	}
+	for _, b := range best {
+		ret[b.idx].Preferred = true
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Peer != ret[j].Peer {
+			return ret[i].Peer < ret[j].Peer
+		}
+		if ret[i].Type != ret[j].Type {
+			return ret[i].Type < ret[j].Type
+		}
+		return ret[i].Address < ret[j].Address
+	})
+	return ret
+}
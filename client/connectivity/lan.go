@@ -0,0 +1,237 @@
+package connectivity
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/sgielen/rufs/proto"
+)
+
+var (
+	lanDiscovery = flag.Bool("lan_discovery", true, "Announce ourselves to and find peers on the local network using multicast")
+
+	lanGroup        = &net.UDPAddr{IP: net.IPv4(239, 255, 82, 85), Port: 12013}
+	lanListenerOnce sync.Once
+)
+
+const (
+	lanAnnounceInterval = 30 * time.Second
+	// lanEndpointTTL is how long we keep using endpoints from LAN announcements after we last heard from the peer.
+	lanEndpointTTL = 3 * lanAnnounceInterval
+	// lanMaxClockSkew limits how old announcements can be, so they can't be replayed later.
+	lanMaxClockSkew = 5 * time.Minute
+)
+
+type lanPeer struct {
+	endpoints []*pb.Endpoint
+	expiry    time.Time
+}
+
+// lanAddresses returns the IP addresses of this machine that are only reachable from the local network.
+func lanAddresses() []string {
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return nil
+	}
+	var ret []string
+	for _, a := range addrs {
+		ipnet, ok := a.(*net.IPNet)
+		if !ok {
+			continue
+		}
+		if isLanIP(ipnet.IP) {
+			ret = append(ret, ipnet.IP.String())
+		}
+	}
+	return ret
+}
+
+func isLanIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4[0] == 10 || (ip4[0] == 172 && ip4[1]&0xf0 == 16) || (ip4[0] == 192 && ip4[1] == 168)
+	}
+	// Unique local address (fc00::/7)
+	return len(ip) == net.IPv6len && ip[0]&0xfe == 0xfc
+}
+
+// myLanEndpoints returns the endpoints peers on the local network can use to reach us.
+func (c *circle) myLanEndpoints() []*pb.Endpoint {
+	var ret []*pb.Endpoint
+	for _, ip := range lanAddresses() {
+		ret = append(ret, &pb.Endpoint{
+			Type:    pb.Endpoint_TCP,
+			Address: net.JoinHostPort(ip, fmt.Sprint(c.myPort)),
+		})
+		if c.udpSocket != nil {
+			udpAddr := net.JoinHostPort(ip, fmt.Sprint(c.udpSocket.LocalPort()))
+			ret = append(ret, &pb.Endpoint{
+				Type:    pb.Endpoint_SCTP_OVER_UDP,
+				Address: udpAddr,
+			}, &pb.Endpoint{
+				Type:    pb.Endpoint_QUIC,
+				Address: udpAddr,
+			})
+		}
+	}
+	return ret
+}
+
+func (c *circle) runLanAnnouncer(ctx context.Context) {
+	if !*lanDiscovery {
+		return
+	}
+	lanListenerOnce.Do(func() {
+		go runLanListener()
+	})
+	conn, err := net.DialUDP("udp4", nil, lanGroup)
+	if err != nil {
+		log.Printf("LAN discovery disabled for %s: %v", c.name, err)
+		return
+	}
+	defer conn.Close()
+	for {
+		if err := c.sendLanAnnouncement(conn); err != nil {
+			log.Printf("Failed to send LAN announcement for %s: %v", c.name, err)
+		}
+		c.expireLanPeers()
+		select {
+		case <-time.After(lanAnnounceInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *circle) sendLanAnnouncement(w io.Writer) error {
+	endpoints := c.myLanEndpoints()
+	if len(endpoints) == 0 {
+		return nil
+	}
+	payload, err := proto.Marshal(&pb.LanAnnouncement_Payload{
+		Circle:    c.name,
+		Peer:      c.myName(),
+		Endpoints: endpoints,
+		Timestamp: time.Now().Unix(),
+	})
+	if err != nil {
+		return err
+	}
+	cert, sig, err := c.keyPair.SignMessage(payload)
+	if err != nil {
+		return err
+	}
+	msg, err := proto.Marshal(&pb.LanAnnouncement{
+		Payload:     payload,
+		Certificate: cert,
+		Signature:   sig,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(msg)
+	return err
+}
+
+func runLanListener() {
+	sock, err := net.ListenMulticastUDP("udp4", nil, lanGroup)
+	if err != nil {
+		log.Printf("Failed to listen for LAN announcements: %v", err)
+		return
+	}
+	buf := make([]byte, 8192)
+	for {
+		n, addr, err := sock.ReadFromUDP(buf)
+		if err != nil {
+			log.Printf("Failed to read LAN announcements: %v", err)
+			return
+		}
+		if err := handleLanAnnouncement(buf[:n]); err != nil {
+			log.Printf("Ignoring LAN announcement from %s: %v", addr, err)
+		}
+	}
+}
+
+func handleLanAnnouncement(data []byte) error {
+	var msg pb.LanAnnouncement
+	if err := proto.Unmarshal(data, &msg); err != nil {
+		return err
+	}
+	var payload pb.LanAnnouncement_Payload
+	if err := proto.Unmarshal(msg.GetPayload(), &payload); err != nil {
+		return err
+	}
+	cmtx.Lock()
+	c, ok := circles[payload.GetCircle()]
+	cmtx.Unlock()
+	if !ok {
+		// Not a circle we're in.
+		return nil
+	}
+	name, err := c.keyPair.VerifyMessage(msg.GetPayload(), msg.GetCertificate(), msg.GetSignature())
+	if err != nil {
+		return err
+	}
+	if name != payload.GetPeer() {
+		return fmt.Errorf("announcement for %q was signed by %q", payload.GetPeer(), name)
+	}
+	if name == c.myName() {
+		return nil
+	}
+	ts := time.Unix(payload.GetTimestamp(), 0)
+	if time.Since(ts) > lanMaxClockSkew || time.Until(ts) > lanMaxClockSkew {
+		return errors.New("announcement is too old or from the future")
+	}
+	c.setLanPeer(name, payload.GetEndpoints())
+	return nil
+}
+
+func (c *circle) setLanPeer(name string, endpoints []*pb.Endpoint) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	old, known := c.lanPeers[name]
+	c.lanPeers[name] = &lanPeer{
+		endpoints: endpoints,
+		expiry:    time.Now().Add(lanEndpointTTL),
+	}
+	if known && endpointsEqual(old.endpoints, endpoints) {
+		return
+	}
+	if po, ok := c.peers[name]; ok {
+		po.update(po.discoveryPeer)
+	}
+}
+
+func (c *circle) expireLanPeers() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	now := time.Now()
+	for name, lp := range c.lanPeers {
+		if lp.expiry.After(now) {
+			continue
+		}
+		delete(c.lanPeers, name)
+		if po, ok := c.peers[name]; ok {
+			po.update(po.discoveryPeer)
+		}
+	}
+}
+
+func endpointsEqual(a, b []*pb.Endpoint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
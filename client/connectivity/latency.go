@@ -0,0 +1,46 @@
+package connectivity
+
+import (
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// latencyMeasuringConn measures the time between the first write and the first read, which is the round trip time of
+// the first packet of the TLS handshake.
+type latencyMeasuringConn struct {
+	net.Conn
+	addr string
+
+	measured  int32
+	mtx       sync.Mutex
+	firstSend time.Time
+}
+
+func newLatencyMeasuringConn(conn net.Conn, addr string) net.Conn {
+	return &latencyMeasuringConn{Conn: conn, addr: addr}
+}
+
+func (c *latencyMeasuringConn) Write(p []byte) (int, error) {
+	if atomic.LoadInt32(&c.measured) == 0 {
+		c.mtx.Lock()
+		if c.firstSend.IsZero() {
+			c.firstSend = time.Now()
+		}
+		c.mtx.Unlock()
+	}
+	return c.Conn.Write(p)
+}
+
+func (c *latencyMeasuringConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	if n > 0 && atomic.LoadInt32(&c.measured) == 0 {
+		c.mtx.Lock()
+		if !c.firstSend.IsZero() && atomic.CompareAndSwapInt32(&c.measured, 0, 1) {
+			recordHandshakeLatency(c.addr, time.Since(c.firstSend))
+		}
+		c.mtx.Unlock()
+	}
+	return n, err
+}
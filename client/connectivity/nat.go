@@ -0,0 +1,82 @@
+package connectivity
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/sgielen/rufs/client/connectivity/udptransport"
+	pb "github.com/sgielen/rufs/proto"
+	"github.com/sgielen/rufs/stunlite"
+)
+
+// natReclassifyInterval is how often we check whether we're still behind the same kind of NAT.
+const natReclassifyInterval = 30 * time.Minute
+
+// runNATClassifier detects what kind of NAT we're behind, and reconnects to the discovery server to tell our peers
+// when it changes.
+func (c *circle) runNATClassifier(ctx context.Context) {
+	if c.udpSocket == nil {
+		return
+	}
+	for {
+		t, err := c.udpSocket.ClassifyNAT(ctx)
+		if errors.Is(err, stunlite.ErrUnsupported) {
+			log.Printf("Discovery server of %s doesn't support NAT type detection", c.name)
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to detect NAT type for %s: %v", c.name, err)
+		} else {
+			c.setNATType(t)
+		}
+		select {
+		case <-time.After(natReclassifyInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *circle) setNATType(t udptransport.NATType) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.natType == t {
+		return
+	}
+	log.Printf("Detected %s NAT for %s", t, c.name)
+	c.natType = t
+	if c.cancelConnect != nil {
+		// Reconnect to announce our new NAT type.
+		c.cancelConnect()
+	}
+}
+
+// punchingPossible returns whether hole punching between us and a peer can work, judging by the NATs we're both
+// behind. A symmetric NAT picks a new port for the peer, which the other side can only guess if its NAT accepts
+// packets from any port.
+func (p *Peer) punchingPossible() bool {
+	c := p.circle
+	c.mtx.Lock()
+	mine := pb.NATType(c.natType)
+	theirs := p.discoveryPeer.GetNatType()
+	c.mtx.Unlock()
+	hard := func(a, b pb.NATType) bool {
+		return a == pb.NATType_SYMMETRIC && (b == pb.NATType_SYMMETRIC || b == pb.NATType_PORT_RESTRICTED)
+	}
+	return !hard(mine, theirs) && !hard(theirs, mine)
+}
+
+// NATTypes returns the kind of NAT we're behind for every circle.
+func NATTypes() map[string]string {
+	cmtx.Lock()
+	defer cmtx.Unlock()
+	ret := make(map[string]string, len(circles))
+	for name, c := range circles {
+		c.mtx.Lock()
+		ret[name] = c.natType.String()
+		c.mtx.Unlock()
+	}
+	return ret
+}
@@ -0,0 +1,135 @@
+package connectivity
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/sgielen/rufs/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	punchMinBackoff = 30 * time.Second
+	punchMaxBackoff = 10 * time.Minute
+	// punchVerifyTimeout is how long we try to set up a QUIC connection after we're done punching.
+	punchVerifyTimeout = 5 * time.Second
+)
+
+// maybeRequestPunch asks the discovery server to coordinate hole punching if we have no direct path to the peer.
+// It's called by the prober, so it doesn't need locking for the punch fields.
+func (p *Peer) maybeRequestPunch(addrs []string) {
+	c := p.circle
+	if p.Name == c.myName() || c.udpSocket == nil || atomic.LoadInt32(&c.punchUnsupported) != 0 {
+		return
+	}
+	hasUDP := false
+	for _, addr := range addrs {
+		if t, _ := splitGrpcAddress(addr); t == pb.Endpoint_QUIC || t == pb.Endpoint_SCTP_OVER_UDP {
+			hasUDP = true
+		}
+	}
+	if !hasUDP || !p.punchingPossible() {
+		return
+	}
+	ready, probed := directPathState(addrs)
+	if ready {
+		p.punchBackoff = 0
+		return
+	}
+	if !probed || time.Now().Before(p.nextPunch) {
+		return
+	}
+	if p.punchBackoff == 0 {
+		p.punchBackoff = punchMinBackoff
+	} else if p.punchBackoff < punchMaxBackoff {
+		p.punchBackoff *= 2
+	}
+	p.nextPunch = time.Now().Add(p.punchBackoff)
+
+	// Don't hold up the prober while the discovery server or the peer is slow to respond.
+	go func(name string) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if _, err := c.client.RequestPunch(ctx, &pb.RequestPunchRequest{Peer: name}); err != nil {
+			if status.Code(err) == codes.Unimplemented {
+				log.Printf("Discovery server of %s doesn't support coordinated hole punching", c.name)
+				atomic.StoreInt32(&c.punchUnsupported, 1)
+				return
+			}
+			log.Printf("Failed to request hole punching to %s: %v", name, err)
+		}
+	}(p.Name)
+}
+
+// handlePunchRequest punches holes to the endpoints of a peer at the time the discovery server told us to, and reports
+// whether we could set up a QUIC connection afterwards.
+func (c *circle) handlePunchRequest(ctx context.Context, req *pb.PunchRequest) {
+	if c.udpSocket == nil {
+		return
+	}
+	time.Sleep(time.Duration(req.GetDelayMillis()) * time.Millisecond)
+	attempts := int(req.GetAttempts())
+	interval := time.Duration(req.GetIntervalMillis()) * time.Millisecond
+	punched := map[string]bool{}
+	var quicEndpoints []*pb.Endpoint
+	for _, e := range req.GetEndpoints() {
+		switch e.GetType() {
+		case pb.Endpoint_QUIC:
+			quicEndpoints = append(quicEndpoints, e)
+		case pb.Endpoint_SCTP_OVER_UDP:
+		default:
+			continue
+		}
+		if punched[e.GetAddress()] {
+			continue
+		}
+		punched[e.GetAddress()] = true
+		go func(addr string) {
+			if err := c.udpSocket.Punch(addr, attempts, interval); err != nil {
+				log.Printf("Failed to punch to %s (%s): %v", req.GetPeer(), addr, err)
+			}
+		}(e.GetAddress())
+	}
+	if len(quicEndpoints) == 0 {
+		// We can't verify whether punching worked.
+		return
+	}
+
+	vctx, cancel := context.WithTimeout(ctx, time.Duration(attempts)*interval+punchVerifyTimeout)
+	defer cancel()
+	successes := make(chan *pb.Endpoint, len(quicEndpoints))
+	for _, e := range quicEndpoints {
+		go func(e *pb.Endpoint) {
+			if err := c.udpSocket.ConnectQUIC(vctx, e.GetAddress()); err != nil {
+				successes <- nil
+				return
+			}
+			successes <- e
+		}(e)
+	}
+	res := &pb.ReportPunchResultRequest{PunchId: req.GetPunchId()}
+	for range quicEndpoints {
+		if e := <-successes; e != nil {
+			res.Success = true
+			res.Endpoint = e
+			break
+		}
+	}
+	if res.GetSuccess() {
+		log.Printf("Punched a hole to %s at %s", req.GetPeer(), res.GetEndpoint().GetAddress())
+		if p := c.GetPeer(req.GetPeer()); p != nil {
+			// Reconnect now rather than waiting for gRPC's backoff. The QUIC connection will be reused.
+			p.conn.ResetConnectBackoff()
+		}
+	} else {
+		log.Printf("Failed to punch a hole to %s", req.GetPeer())
+	}
+	rctx, rcancel := context.WithTimeout(ctx, 10*time.Second)
+	defer rcancel()
+	if _, err := c.client.ReportPunchResult(rctx, res); err != nil {
+		log.Printf("Failed to report hole punching result to %s: %v", c.name, err)
+	}
+}
@@ -0,0 +1,94 @@
+package connectivity
+
+import (
+	"context"
+	"log"
+	"net"
+	"time"
+
+	pb "github.com/sgielen/rufs/proto"
+	"github.com/sgielen/rufs/relay"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
+)
+
+// relayGracePeriod is how long we give direct connections to a peer to come up before we connect through the relay.
+const relayGracePeriod = 10 * time.Second
+
+// runRelayListener makes us reachable through the relay of the discovery server, for peers that can't connect to us
+// directly.
+func (c *circle) runRelayListener(ctx context.Context) {
+	for {
+		err := c.relayListen(ctx)
+		if status.Code(err) == codes.Unimplemented {
+			log.Printf("Discovery server of %s doesn't support relaying", c.name)
+			return
+		}
+		log.Printf("Relay listener for %s stopped: %v", c.name, err)
+		time.Sleep(time.Second)
+	}
+}
+
+func (c *circle) relayListen(ctx context.Context) error {
+	stream, err := c.relayClient.Listen(ctx, &pb.RelayListenRequest{}, grpc.WaitForReady(true))
+	if err != nil {
+		return err
+	}
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		go func() {
+			actx, cancel := context.WithTimeout(ctx, 10*time.Second)
+			defer cancel()
+			conn, err := relay.Accept(actx, c.relayClient, msg)
+			if err != nil {
+				log.Printf("Failed to accept relayed connection from %s: %v", msg.GetPeer(), err)
+				return
+			}
+			HandleIncomingContentConnection(conn)
+		}()
+	}
+}
+
+// dialRelay connects to a peer through the relay. The relay is only used as a last resort, so we wait until we don't
+// have a direct connection to the peer. If we can't connect directly anyway, we don't give direct connections time to
+// come up.
+func (c *circle) dialRelay(ctx context.Context, peer string) (net.Conn, error) {
+	p := c.GetPeer(peer)
+	if p == nil || p.directPossible() {
+		select {
+		case <-time.After(relayGracePeriod):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if p != nil {
+		// The relay subconn isn't connected, so if the channel is ready we have a direct connection.
+		for p.conn.GetState() == connectivity.Ready {
+			if !p.conn.WaitForStateChange(ctx, connectivity.Ready) {
+				return nil, ctx.Err()
+			}
+		}
+	}
+	return relay.Dial(ctx, c.relayClient, peer)
+}
+
+// directPossible returns whether we might be able to reach a peer without the relay. Through a proxy we can only make
+// TCP connections. Otherwise, UDP connections need hole punching to work.
+func (p *Peer) directPossible() bool {
+	if !p.circle.proxied {
+		return p.punchingPossible()
+	}
+	p.circle.mtx.Lock()
+	defer p.circle.mtx.Unlock()
+	for _, e := range p.discoveryPeer.GetEndpoints() {
+		if e.GetType() == pb.Endpoint_TCP || e.GetType() == pb.Endpoint_WEBSOCKET {
+			return true
+		}
+	}
+	return false
+}
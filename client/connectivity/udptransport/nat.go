@@ -0,0 +1,169 @@
+package udptransport
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/sgielen/rufs/stunlite"
+)
+
+// NATType is the kind of NAT we're behind. The values match those of pb.NATType.
+type NATType int
+
+const (
+	NATUnknown NATType = iota
+	// NATOpen means we're not behind a NAT.
+	NATOpen
+	// NATFullCone accepts packets from anyone to our mapped address.
+	NATFullCone
+	// NATRestricted only accepts packets from IP addresses we've sent packets to.
+	NATRestricted
+	// NATPortRestricted only accepts packets from addresses (IP and port) we've sent packets to.
+	NATPortRestricted
+	// NATSymmetric maps our socket to a different port for every destination.
+	NATSymmetric
+)
+
+func (t NATType) String() string {
+	switch t {
+	case NATOpen:
+		return "open"
+	case NATFullCone:
+		return "full cone"
+	case NATRestricted:
+		return "restricted"
+	case NATPortRestricted:
+		return "port-restricted"
+	case NATSymmetric:
+		return "symmetric"
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	stunTimeout = 5 * time.Second
+	// filterTimeout is how long we wait for replies that our NAT might drop.
+	filterTimeout = 3 * time.Second
+)
+
+// ClassifyNAT finds out what kind of NAT we're behind by sending requests to the stunlite server over IPv4 (or IPv6 if
+// the server doesn't have an IPv4 address).
+//
+// Sending to the alternate port of the server opens our NAT for replies from it, so that is done after the filtering
+// tests. Full cone NATs can only be told apart from restricted NATs if the server has an alternate IP address;
+// otherwise they're classified as restricted.
+func (s *Socket) ClassifyNAT(ctx context.Context) (NATType, error) {
+	s.stunMtx.Lock()
+	defer s.stunMtx.Unlock()
+	raddrs, err := s.resolveStunliteServer(ctx)
+	if err != nil {
+		return NATUnknown, err
+	}
+	raddr := raddrs[0]
+	primary := s.multiplexer.GetBypassCallback(raddr)
+	reply, err := stunRequest(ctx, primary, primary, stunlite.Info, stunTimeout)
+	if errors.Is(err, errUnexpectedReply) {
+		// Servers that don't understand Info requests treat them like Echo requests.
+		return NATUnknown, stunlite.ErrUnsupported
+	}
+	if err != nil {
+		return NATUnknown, err
+	}
+	altPort, altAddr, err := stunlite.ParseInfo(reply)
+	if err != nil {
+		return NATUnknown, err
+	}
+	reply, err = stunRequest(ctx, primary, primary, stunlite.Echo, stunTimeout)
+	if err != nil {
+		return NATUnknown, err
+	}
+	mapped := string(reply)
+	if s.isLocalAddress(mapped) {
+		return NATOpen, nil
+	}
+
+	if altAddr != "" {
+		a, err := net.ResolveUDPAddr("udp", altAddr)
+		if err == nil {
+			if _, err := stunRequest(ctx, primary, s.multiplexer.GetBypassCallback(a), stunlite.ChangeAddress, filterTimeout); err == nil {
+				return NATFullCone, nil
+			}
+		}
+	}
+	alternate := s.multiplexer.GetBypassCallback(&net.UDPAddr{IP: raddr.IP, Port: altPort, Zone: raddr.Zone})
+	_, err = stunRequest(ctx, primary, alternate, stunlite.ChangePort, filterTimeout)
+	restricted := err == nil
+
+	reply, err = stunRequest(ctx, alternate, alternate, stunlite.Echo, stunTimeout)
+	if err != nil {
+		return NATUnknown, err
+	}
+	if string(reply) != mapped {
+		return NATSymmetric, nil
+	}
+	if restricted {
+		return NATRestricted, nil
+	}
+	return NATPortRestricted, nil
+}
+
+// errUnexpectedReply is returned by stunRequest if only replies to other requests arrived. Those are late replies to
+// earlier requests, or replies from a server that doesn't understand op.
+var errUnexpectedReply = errors.New("unexpected reply from stunlite server")
+
+// stunRequest sends op to the stunlite server through `to` until a reply to it arrives on `from` or the timeout
+// expires.
+func stunRequest(ctx context.Context, to, from *semiConnectedUDP, op byte, timeout time.Duration) ([]byte, error) {
+	from.discardPending()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	go func() {
+		b := backoff.NewExponentialBackOff()
+		b.InitialInterval = 100 * time.Millisecond
+		_ = backoff.Retry(func() error {
+			_, err := to.Write([]byte{op})
+			return err
+		}, backoff.WithContext(b, ctx))
+	}()
+
+	from.SetReadDeadline(time.Now().Add(timeout))
+	defer from.SetReadDeadline(time.Time{})
+	unexpected := false
+	for {
+		// Large enough for "[IPv6%zone]:port".
+		res := [128]byte{}
+		n, err := from.Read(res[:])
+		if err != nil {
+			if unexpected {
+				return nil, errUnexpectedReply
+			}
+			return nil, err
+		}
+		if stunlite.IsInfo(res[:n]) == (op == stunlite.Info) {
+			return res[:n], nil
+		}
+		unexpected = true
+	}
+}
+
+// isLocalAddress returns whether addr is the address of our socket on one of our interfaces.
+func (s *Socket) isLocalAddress(addr string) bool {
+	a, err := net.ResolveUDPAddr("udp", addr)
+	if err != nil || a.Port != s.LocalPort() {
+		return false
+	}
+	ifaddrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return false
+	}
+	for _, ifaddr := range ifaddrs {
+		if ipnet, ok := ifaddr.(*net.IPNet); ok && ipnet.IP.Equal(a.IP) {
+			return true
+		}
+	}
+	return false
+}
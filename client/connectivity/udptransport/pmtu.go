@@ -0,0 +1,137 @@
+package udptransport
+
+import (
+	"bytes"
+	"encoding/binary"
+	"log"
+	"net"
+	"time"
+)
+
+// Path MTU discovery in the style of DPLPMTUD (RFC 8899). Before we set up an SCTP association with a peer, we send
+// it probe packets of several sizes, and it acknowledges the ones that arrive. The association uses the largest
+// acknowledged size for its packets. Probes don't get fragmented, because the socket sets the Don't Fragment bit and
+// ignores the kernel's path MTU estimate (see setPMTUProbing).
+//
+// pion/sctp can't change the packet size of an existing association, so the size is only determined once per
+// association.
+
+const (
+	// maxPacketSize is the largest UDP payload in a 1500 byte Ethernet frame.
+	maxPacketSize = 1500 - 20 - 8
+	// maxPacketSizeIPv6 is smaller, because IPv6 headers are larger.
+	maxPacketSizeIPv6 = 1500 - 40 - 8
+
+	pmtuProbeAttempts = 3
+	pmtuProbeInterval = 200 * time.Millisecond
+
+	pmtuProbe byte = 1
+	pmtuAck   byte = 2
+)
+
+var (
+	// pmtuProbeSizes are the packet sizes we try, in increasing order. Smaller paths get pion/sctp's default of 1228
+	// bytes.
+	pmtuProbeSizes = []int{1280, 1350, 1400, 1420, maxPacketSizeIPv6, maxPacketSize}
+
+	// pmtuMagic starts all probes and acks. The zero byte distinguishes them from SCTP packets (which start with
+	// source port 5000), QUIC packets (which have the fixed bit set) and stunlite responses (which are text).
+	pmtuMagic = []byte{0, 'r', 'u', 'f', 's', 'm', 't', 'u'}
+)
+
+// pmtuHeaderSize is the size of magic, type and packet size.
+var pmtuHeaderSize = len(pmtuMagic) + 3
+
+func isPMTUPacket(data []byte) bool {
+	return len(data) >= pmtuHeaderSize && bytes.Equal(data[:len(pmtuMagic)], pmtuMagic)
+}
+
+func newPMTUPacket(typ byte, size int, padTo int) []byte {
+	ret := make([]byte, pmtuHeaderSize, padTo)
+	copy(ret, pmtuMagic)
+	ret[len(pmtuMagic)] = typ
+	binary.BigEndian.PutUint16(ret[len(pmtuMagic)+1:], uint16(size))
+	return ret[:padTo]
+}
+
+// handlePMTUPacket acknowledges probes and passes acks to probePMTU.
+func (m *udpMultiplexer) handlePMTUPacket(addr *net.UDPAddr, data []byte) {
+	size := int(binary.BigEndian.Uint16(data[len(pmtuMagic)+1:]))
+	switch data[len(pmtuMagic)] {
+	case pmtuProbe:
+		if size != len(data) {
+			return
+		}
+		if _, err := m.sock.WriteToUDP(newPMTUPacket(pmtuAck, size, pmtuHeaderSize), addr); err != nil {
+			log.Printf("Failed to acknowledge path MTU probe from %s: %v", addr, err)
+		}
+	case pmtuAck:
+		m.mtx.Lock()
+		ch := m.pmtuAcks[addr.String()]
+		m.mtx.Unlock()
+		select {
+		case ch <- size:
+		default:
+		}
+	}
+}
+
+// probePMTU returns the largest packet size that reaches addr, or 0 if we don't know (because we can't probe, or the
+// peer doesn't answer probes).
+func (s *Socket) probePMTU(addr *net.UDPAddr) int {
+	if !s.pmtuProbing {
+		return 0
+	}
+	max := maxPacketSize
+	if addr.IP.To4() == nil {
+		max = maxPacketSizeIPv6
+	}
+	var sizes []int
+	for _, size := range pmtuProbeSizes {
+		if size <= max {
+			sizes = append(sizes, size)
+		}
+	}
+
+	m := s.multiplexer
+	key := addr.String()
+	acks := make(chan int, len(sizes)*pmtuProbeAttempts)
+	m.mtx.Lock()
+	m.pmtuAcks[key] = acks
+	m.mtx.Unlock()
+	defer func() {
+		m.mtx.Lock()
+		delete(m.pmtuAcks, key)
+		m.mtx.Unlock()
+	}()
+
+	best := 0
+	send := func() {
+		for _, size := range sizes {
+			if size > best {
+				// Errors like EMSGSIZE just mean this size doesn't work.
+				_, _ = s.sock.WriteToUDP(newPMTUPacket(pmtuProbe, size, size), addr)
+			}
+		}
+	}
+	send()
+	ticker := time.NewTicker(pmtuProbeInterval)
+	defer ticker.Stop()
+	for attempt := 1; ; {
+		select {
+		case size := <-acks:
+			if size > best {
+				best = size
+			}
+			if best == sizes[len(sizes)-1] {
+				return best
+			}
+		case <-ticker.C:
+			if attempt == pmtuProbeAttempts {
+				return best
+			}
+			attempt++
+			send()
+		}
+	}
+}
@@ -0,0 +1,29 @@
+// +build linux
+
+package udptransport
+
+import (
+	"net"
+
+	"golang.org/x/sys/unix"
+)
+
+// setPMTUProbing makes the kernel set the Don't Fragment bit on our packets without limiting them to its own path MTU
+// estimate, so we can send probes larger than that.
+func setPMTUProbing(sock *net.UDPConn) error {
+	rc, err := sock.SyscallConn()
+	if err != nil {
+		return err
+	}
+	var v4err, v6err error
+	if err := rc.Control(func(fd uintptr) {
+		v4err = unix.SetsockoptInt(int(fd), unix.IPPROTO_IP, unix.IP_MTU_DISCOVER, unix.IP_PMTUDISC_PROBE)
+		v6err = unix.SetsockoptInt(int(fd), unix.IPPROTO_IPV6, unix.IPV6_MTU_DISCOVER, unix.IPV6_PMTUDISC_PROBE)
+	}); err != nil {
+		return err
+	}
+	if v6err != nil && sock.LocalAddr().(*net.UDPAddr).IP.To4() == nil {
+		return v6err
+	}
+	return v4err
+}
@@ -0,0 +1,12 @@
+// +build !linux
+
+package udptransport
+
+import (
+	"errors"
+	"net"
+)
+
+func setPMTUProbing(sock *net.UDPConn) error {
+	return errors.New("not supported on this platform")
+}
@@ -0,0 +1,166 @@
+package udptransport
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"log"
+	"net"
+	"time"
+
+	"github.com/quic-go/quic-go"
+)
+
+const quicALPN = "rufs"
+
+var quicConfig = &quic.Config{
+	MaxIdleTimeout:  60 * time.Second,
+	KeepAlivePeriod: 15 * time.Second,
+	// Our multiplexer drops packets larger than maxPacketSize.
+	DisablePathMTUDiscovery: true,
+	MaxIncomingStreams:      1000,
+}
+
+// EnableQUIC accepts QUIC connections on the socket, and allows dialing them with DialQUIC. Like with SCTP, each
+// stream is a separate net.Conn and streams on incoming connections are passed to newStreamCallback.
+func (s *Socket) EnableQUIC(tlsConfig *tls.Config) error {
+	tlsConfig = tlsConfig.Clone()
+	tlsConfig.NextProtos = []string{quicALPN}
+	tr := &quic.Transport{Conn: s.multiplexer.QUICPacketConn()}
+	ln, err := tr.Listen(tlsConfig, quicConfig)
+	if err != nil {
+		return err
+	}
+	s.mtx.Lock()
+	s.quicTransport = tr
+	s.quicTLSConfig = tlsConfig
+	s.mtx.Unlock()
+	go s.acceptQUIC(ln)
+	return nil
+}
+
+func (s *Socket) acceptQUIC(ln *quic.Listener) {
+	for {
+		conn, err := ln.Accept(context.Background())
+		if err != nil {
+			log.Printf("Stopped accepting QUIC connections: %v", err)
+			return
+		}
+		go s.handleIncomingQUICStreams(conn)
+	}
+}
+
+func (s *Socket) handleIncomingQUICStreams(conn *quic.Conn) {
+	for {
+		stream, err := conn.AcceptStream(context.Background())
+		if err != nil {
+			return
+		}
+		s.newStreamCallback(&quicStreamWrapper{Stream: stream, conn: conn})
+	}
+}
+
+// DialQUIC opens a new stream to addr, reusing the QUIC connection to addr if we have one.
+func (s *Socket) DialQUIC(ctx context.Context, addr string) (net.Conn, error) {
+	raddr, err := net.ResolveUDPAddr("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := s.quicConnection(ctx, raddr)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := conn.OpenStreamSync(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &quicStreamWrapper{Stream: stream, conn: conn}, nil
+}
+
+// ConnectQUIC sets up a QUIC connection to addr without opening a stream. DialQUIC will reuse it.
+func (s *Socket) ConnectQUIC(ctx context.Context, addr string) error {
+	raddr, err := net.ResolveUDPAddr("udp", addr)
+	if err != nil {
+		return err
+	}
+	_, err = s.quicConnection(ctx, raddr)
+	return err
+}
+
+func (s *Socket) quicConnection(ctx context.Context, raddr *net.UDPAddr) (*quic.Conn, error) {
+	key := raddr.String()
+	s.mtx.Lock()
+	tr := s.quicTransport
+	conn, ok := s.quicConns[key]
+	s.mtx.Unlock()
+	if tr == nil {
+		return nil, errors.New("QUIC is not enabled")
+	}
+	if ok && conn.Context().Err() == nil {
+		return conn, nil
+	}
+	// The dial is shared with concurrent callers, so it shouldn't fail if our ctx is cancelled. The handshake timeout
+	// bounds it.
+	ch := s.quicDials.DoChan(key, func() (interface{}, error) {
+		return s.dialQUIC(context.WithoutCancel(ctx), raddr)
+	})
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*quic.Conn), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// dialQUIC sets up a QUIC connection to raddr and remembers it until it's closed.
+func (s *Socket) dialQUIC(ctx context.Context, raddr *net.UDPAddr) (*quic.Conn, error) {
+	key := raddr.String()
+	s.mtx.Lock()
+	tr := s.quicTransport
+	tlsConfig := s.quicTLSConfig
+	s.mtx.Unlock()
+	conn, err := tr.Dial(ctx, raddr, tlsConfig, quicConfig)
+	if err != nil {
+		return nil, err
+	}
+	s.mtx.Lock()
+	if existing, ok := s.quicConns[key]; ok && existing.Context().Err() == nil {
+		s.mtx.Unlock()
+		// Someone else set up a connection in the meantime.
+		conn.CloseWithError(0, "duplicate connection")
+		return existing, nil
+	}
+	s.quicConns[key] = conn
+	s.mtx.Unlock()
+	go func() {
+		<-conn.Context().Done()
+		s.mtx.Lock()
+		defer s.mtx.Unlock()
+		if s.quicConns[key] == conn {
+			delete(s.quicConns, key)
+		}
+	}()
+	return conn, nil
+}
+
+// quicStreamWrapper makes a QUIC stream a net.Conn.
+type quicStreamWrapper struct {
+	*quic.Stream
+	conn *quic.Conn
+}
+
+func (w *quicStreamWrapper) Close() error {
+	w.Stream.CancelRead(0)
+	return w.Stream.Close()
+}
+
+func (w *quicStreamWrapper) LocalAddr() net.Addr {
+	return w.conn.LocalAddr()
+}
+
+func (w *quicStreamWrapper) RemoteAddr() net.Addr {
+	return w.conn.RemoteAddr()
+}
@@ -25,11 +25,11 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to enable gRPC-over-UDP: %v", err)
 	}
-	udpEndpoint, err := udpSocket.PerformStunlite(ctx)
+	udpEndpoints, err := udpSocket.PerformStunlite(ctx)
 	if err != nil {
 		log.Fatalf("Stunlite failed: %v", err)
 	}
-	log.Printf("Stunlite: %s", udpEndpoint)
+	log.Printf("Stunlite: %v", udpEndpoints)
 
 	var remoteB [128]byte
 	n, err := os.Stdin.Read(remoteB[:])
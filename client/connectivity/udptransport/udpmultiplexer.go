@@ -11,11 +11,9 @@ import (
 	"github.com/sgielen/rufs/client/connectivity/udptransport/deadlinech"
 )
 
-const mtu = 1400
-
 var pool = sync.Pool{
 	New: func() interface{} {
-		buf := make([]byte, mtu+1)
+		buf := make([]byte, maxPacketSize+1)
 		return &buf
 	},
 }
@@ -27,6 +25,9 @@ type udpMultiplexer struct {
 
 	mtx         sync.Mutex
 	connections map[string]*semiConnectedUDP
+	quic        *quicPacketConn
+	// pmtuAcks receives the sizes of acknowledged path MTU probes, per peer address.
+	pmtuAcks map[string]chan int
 }
 
 func newUDPMultiplexer(sock *net.UDPConn, newPeerCallback func(net.Conn)) *udpMultiplexer {
@@ -34,8 +35,8 @@ func newUDPMultiplexer(sock *net.UDPConn, newPeerCallback func(net.Conn)) *udpMu
 		sock:            sock,
 		newPeerCallback: newPeerCallback,
 		connections:     map[string]*semiConnectedUDP{},
+		pmtuAcks:        map[string]chan int{},
 	}
-	go m.reader()
 	return m
 }
 
@@ -47,8 +48,44 @@ func (m *udpMultiplexer) GetBlocking(addr *net.UDPAddr) net.Conn {
 	return a
 }
 
-func (m *udpMultiplexer) GetBypassCallback(addr *net.UDPAddr) net.Conn {
-	return m.get(addr, func(net.Conn) {})
+func (m *udpMultiplexer) GetBypassCallback(addr *net.UDPAddr) *semiConnectedUDP {
+	c := m.get(addr, func(net.Conn) {})
+	m.mtx.Lock()
+	c.bypass = true
+	m.mtx.Unlock()
+	return c
+}
+
+// QUICPacketConn returns a PacketConn that receives all QUIC packets arriving on the socket.
+func (m *udpMultiplexer) QUICPacketConn() net.PacketConn {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.quic == nil {
+		m.quic = &quicPacketConn{
+			udpConn:      m.sock,
+			msgs:         make(chan message, 100),
+			quit:         make(chan struct{}),
+			readDeadline: deadlinech.New(),
+			onWrite:      func() { atomic.StoreInt32(&m.hadWrites, 1) },
+		}
+	}
+	return m.quic
+}
+
+// quicTarget returns where a packet should go if it's a QUIC packet, or nil if it should be handled as SCTP.
+func (m *udpMultiplexer) quicTarget(addr *net.UDPAddr, data []byte) *quicPacketConn {
+	// QUIC packets always have the fixed bit set (RFC 9000, section 17). Our SCTP packets start with source port 5000,
+	// which doesn't have that bit set.
+	if len(data) == 0 || data[0]&0x40 == 0 {
+		return nil
+	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if c, ok := m.connections[addr.String()]; ok && c.bypass {
+		// Stunlite responses can start with any character.
+		return nil
+	}
+	return m.quic
 }
 
 func (m *udpMultiplexer) GetHadWritesAndClear() bool {
@@ -86,8 +123,19 @@ func (m *udpMultiplexer) reader() {
 		if err != nil {
 			panic(err)
 		}
-		if n > mtu {
+		if n == 0 {
+			// Punch packets are only sent to open NAT mappings.
+			pool.Put(buf)
+			continue
+		}
+		if n > maxPacketSize {
 			// Message is too large. Shouldn't happen with our SCTP settings. Dropping it.
+			pool.Put(buf)
+			continue
+		}
+		if isPMTUPacket((*buf)[:n]) {
+			m.handlePMTUPacket(addr, (*buf)[:n])
+			pool.Put(buf)
 			continue
 		}
 		msg := message{
@@ -95,6 +143,14 @@ func (m *udpMultiplexer) reader() {
 			peer:  addr,
 			alloc: buf,
 		}
+		if q := m.quicTarget(addr, msg.data); q != nil {
+			select {
+			case q.msgs <- msg:
+			default:
+				// Channel is full. Drop the packet.
+			}
+			continue
+		}
 		c := m.get(addr, m.newPeerCallback)
 		select {
 		case c.msgs <- msg:
@@ -119,6 +175,8 @@ type semiConnectedUDP struct {
 	initialized  chan struct{}
 	readDeadline *deadlinech.DeadlineChannel
 	onWrite      func()
+	// bypass is set for connections that are not SCTP associations, like the one to the stunlite server.
+	bypass bool
 }
 
 func (t *semiConnectedUDP) Write(p []byte) (int, error) {
@@ -146,6 +204,18 @@ func (t *semiConnectedUDP) Read(p []byte) (int, error) {
 	}
 }
 
+// discardPending drops the packets that were received but not read yet.
+func (t *semiConnectedUDP) discardPending() {
+	for {
+		select {
+		case m := <-t.msgs:
+			pool.Put(m.alloc)
+		default:
+			return
+		}
+	}
+}
+
 func (t *semiConnectedUDP) Close() error {
 	close(t.quit)
 	return nil
@@ -178,3 +248,71 @@ func (t *semiConnectedUDP) SetWriteDeadline(ts time.Time) error {
 	log.Printf("Ignoring SetWriteDeadline(%s) call", ts)
 	return nil
 }
+
+// quicPacketConn is the net.PacketConn the QUIC transport reads from and writes to.
+type quicPacketConn struct {
+	udpConn *net.UDPConn
+
+	msgs         chan message
+	quit         chan struct{}
+	closeOnce    sync.Once
+	readDeadline *deadlinech.DeadlineChannel
+	onWrite      func()
+}
+
+func (q *quicPacketConn) ReadFrom(p []byte) (int, net.Addr, error) {
+	select {
+	case m := <-q.msgs:
+		n := copy(p, m.data)
+		pool.Put(m.alloc)
+		return n, m.peer, nil
+	case <-q.quit:
+		return 0, nil, net.ErrClosed
+	case <-q.readDeadline.Wait():
+		return 0, nil, deadlineExceeded{}
+	}
+}
+
+func (q *quicPacketConn) WriteTo(p []byte, addr net.Addr) (int, error) {
+	select {
+	case <-q.quit:
+		return 0, net.ErrClosed
+	default:
+		q.onWrite()
+		return q.udpConn.WriteTo(p, addr)
+	}
+}
+
+func (q *quicPacketConn) Close() error {
+	q.closeOnce.Do(func() {
+		close(q.quit)
+	})
+	return nil
+}
+
+func (q *quicPacketConn) LocalAddr() net.Addr {
+	return q.udpConn.LocalAddr()
+}
+
+func (q *quicPacketConn) SetDeadline(ts time.Time) error {
+	return q.SetReadDeadline(ts)
+}
+
+func (q *quicPacketConn) SetReadDeadline(ts time.Time) error {
+	q.readDeadline.Set(ts)
+	return nil
+}
+
+func (q *quicPacketConn) SetWriteDeadline(ts time.Time) error {
+	return nil
+}
+
+// SetReadBuffer is called by quic-go to increase the buffer size of the shared socket.
+func (q *quicPacketConn) SetReadBuffer(bytes int) error {
+	return q.udpConn.SetReadBuffer(bytes)
+}
+
+// SetWriteBuffer is called by quic-go to increase the buffer size of the shared socket.
+func (q *quicPacketConn) SetWriteBuffer(bytes int) error {
+	return q.udpConn.SetWriteBuffer(bytes)
+}
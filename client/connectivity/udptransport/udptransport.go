@@ -3,34 +3,50 @@ package udptransport
 import (
 	"context"
 	"crypto/rand"
+	"crypto/tls"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net"
+	"strings"
 	"sync"
 	"time"
 
-	"github.com/cenkalti/backoff/v4"
 	"github.com/ory/go-convenience/stringslice"
 	"github.com/pion/logging"
 	"github.com/pion/sctp"
+	"github.com/quic-go/quic-go"
 	"github.com/sgielen/rufs/client/connectivity/udptransport/deadlinech"
+	"github.com/sgielen/rufs/stunlite"
+	"golang.org/x/sync/singleflight"
 )
 
 const writeBufferSize = 16384
 
+// sctpOverhead is the size of the SCTP common header and a DATA chunk header.
+const sctpOverhead = 12 + 16
+
 type Socket struct {
 	sock              *net.UDPConn
 	newStreamCallback func(net.Conn)
 	multiplexer       *udpMultiplexer
 	loggerFactory     logging.LoggerFactory
-	stunliteServer    string
-
-	mtx          sync.Mutex
-	associations map[string]*association
-	stunAddrs    []string
+	// pmtuProbing is set if we can send path MTU probes.
+	pmtuProbing bool
+	// stunMtx serializes requests to the stunlite server, so they don't read each other's replies.
+	stunMtx sync.Mutex
+	// quicDials deduplicates concurrent dials to the same address.
+	quicDials singleflight.Group
+
+	mtx            sync.Mutex
+	stunliteServer string
+	associations   map[string]*association
+	stunAddrs      []string
+	quicTransport  *quic.Transport
+	quicTLSConfig  *tls.Config
+	quicConns      map[string]*quic.Conn
 }
 
 type association struct {
@@ -39,19 +55,30 @@ type association struct {
 }
 
 func New(newStreamCallback func(net.Conn), stunliteServer string) (*Socket, error) {
-	sock, err := net.ListenUDP("udp4", nil)
+	// Listen on both IPv4 and IPv6 if the system supports it.
+	sock, err := net.ListenUDP("udp", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to enable gRPC-over-UDP: %v", err)
 	}
 
+	pmtuProbing := true
+	if err := setPMTUProbing(sock); err != nil {
+		log.Printf("Path MTU discovery for gRPC-over-UDP disabled: %v", err)
+		pmtuProbing = false
+	}
+
 	s := &Socket{
 		sock:              sock,
+		pmtuProbing:       pmtuProbing,
 		newStreamCallback: newStreamCallback,
 		loggerFactory:     logging.NewDefaultLoggerFactory(),
 		associations:      map[string]*association{},
+		quicConns:         map[string]*quic.Conn{},
 		stunliteServer:    stunliteServer,
 	}
 	s.multiplexer = newUDPMultiplexer(sock, s.handleNewConnection)
+	// Only start reading once s.multiplexer is set, as handleNewConnection uses it to probe the path MTU.
+	go s.multiplexer.reader()
 
 	go s.keepaliver()
 
@@ -66,47 +93,127 @@ func (s *Socket) keepaliver() {
 	}
 }
 
-func (s *Socket) PerformStunlite(ctx context.Context) (string, error) {
-	raddr, err := net.ResolveUDPAddr("udp4", s.stunliteServer)
+// SetStunliteServer changes the address of the stunlite server, for when we've switched to another address of the
+// discovery server.
+func (s *Socket) SetStunliteServer(addr string) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.stunliteServer = addr
+}
+
+// PerformStunlite asks the stunlite server for our public UDP endpoints. It tries both IPv4 and IPv6 if the stunlite
+// server has addresses in both families, and returns the endpoints that succeeded.
+func (s *Socket) PerformStunlite(ctx context.Context) ([]string, error) {
+	s.stunMtx.Lock()
+	defer s.stunMtx.Unlock()
+	raddrs, err := s.resolveStunliteServer(ctx)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
+	type result struct {
+		addr string
+		err  error
+	}
+	results := make([]result, len(raddrs))
+	var wg sync.WaitGroup
+	wg.Add(len(raddrs))
+	for i, raddr := range raddrs {
+		i, raddr := i, raddr
+		go func() {
+			defer wg.Done()
+			addr, err := s.stunlite(ctx, raddr)
+			results[i] = result{addr, err}
+		}()
+	}
+	wg.Wait()
+	var ret []string
+	var errs []string
+	for i, r := range results {
+		if r.err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", raddrs[i], r.err))
+			continue
+		}
+		ret = append(ret, r.addr)
+	}
+	if len(ret) == 0 {
+		return nil, errors.New(strings.Join(errs, "; "))
+	}
+	return ret, nil
+}
 
-	sock := s.multiplexer.GetBypassCallback(raddr)
-
-	_, err = sock.Write(nil)
+// resolveStunliteServer returns at most one IPv4 and one IPv6 address of the stunlite server.
+func (s *Socket) resolveStunliteServer(ctx context.Context) ([]*net.UDPAddr, error) {
+	s.mtx.Lock()
+	server := s.stunliteServer
+	s.mtx.Unlock()
+	host, port, err := net.SplitHostPort(server)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
+	portNum, err := net.DefaultResolver.LookupPort(ctx, "udp", port)
+	if err != nil {
+		return nil, err
+	}
+	ips, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	var v4, v6 *net.UDPAddr
+	for _, ip := range ips {
+		if ip.IP.To4() != nil {
+			if v4 == nil {
+				v4 = &net.UDPAddr{IP: ip.IP, Port: portNum}
+			}
+		} else if v6 == nil {
+			v6 = &net.UDPAddr{IP: ip.IP, Port: portNum, Zone: ip.Zone}
+		}
+	}
+	var ret []*net.UDPAddr
+	if v4 != nil {
+		ret = append(ret, v4)
+	}
+	if v6 != nil {
+		ret = append(ret, v6)
+	}
+	if len(ret) == 0 {
+		return nil, fmt.Errorf("no addresses found for %q", host)
+	}
+	return ret, nil
+}
 
-	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
-	defer cancel()
-	go func() {
-		b := backoff.NewExponentialBackOff()
-		b.InitialInterval = 100 * time.Millisecond
-		_ = backoff.Retry(func() error {
-			_, err := sock.Write(nil)
-			return err
-		}, backoff.WithContext(b, ctx))
-	}()
-
-	sock.SetReadDeadline(time.Now().Add(5 * time.Second))
-	res := [22]byte{}
-	n, err := sock.Read(res[:])
+func (s *Socket) stunlite(ctx context.Context, raddr *net.UDPAddr) (string, error) {
+	sock := s.multiplexer.GetBypassCallback(raddr)
+	res, err := stunRequest(ctx, sock, sock, stunlite.Echo, stunTimeout)
 	if err != nil {
 		return "", err
 	}
-	ret := string(res[:n])
+	ret := string(res)
 	s.mtx.Lock()
-	if len(s.stunAddrs) > 2 {
-		s.stunAddrs = s.stunAddrs[:2]
+	if len(s.stunAddrs) > 4 {
+		s.stunAddrs = s.stunAddrs[:4]
 	}
 	s.stunAddrs = append(s.stunAddrs, ret)
 	s.mtx.Unlock()
-	sock.SetReadDeadline(time.Time{})
 	return ret, nil
 }
 
+// Punch sends empty packets to addr to open a mapping in NATs between us. The other side ignores them.
+func (s *Socket) Punch(addr string, attempts int, interval time.Duration) error {
+	raddr, err := net.ResolveUDPAddr("udp", addr)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < attempts; i++ {
+		if i > 0 {
+			time.Sleep(interval)
+		}
+		if _, err := s.sock.WriteToUDP(nil, raddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *Socket) LocalAddr() net.Addr {
 	return s.sock.LocalAddr()
 }
@@ -116,9 +223,17 @@ func (s *Socket) LocalPort() int {
 }
 
 func (s *Socket) handleNewConnection(c net.Conn) {
+	var pmtu int
+	if _, ok := c.(*semiConnectedUDP); ok {
+		pmtu = s.probePMTU(c.RemoteAddr().(*net.UDPAddr))
+		if pmtu > 0 {
+			log.Printf("Path MTU to %s is %d bytes", c.RemoteAddr().String(), pmtu)
+		}
+	}
 	assoc, err := sctp.Client(sctp.Config{
 		NetConn:        c,
-		MaxMessageSize: 1400,
+		MaxMessageSize: maxPacketSize,
+		MTU:            uint32(pmtu),
 		LoggerFactory:  s.loggerFactory,
 	})
 	if err != nil {
@@ -167,7 +282,7 @@ func (s *Socket) handleIncomingStreams(assoc *sctp.Association, raddr net.Addr,
 			continue
 		}
 		log.Printf("AcceptStream returned %d from %s", stream.StreamIdentifier(), raddr.String())
-		s.newStreamCallback(wrapSctpStream(stream, raddr))
+		s.newStreamCallback(wrapSctpStream(assoc, stream, raddr))
 	}
 }
 
@@ -229,14 +344,15 @@ func (s *Socket) DialContext(ctx context.Context, addr string) (net.Conn, error)
 		return nil, fmt.Errorf("failed to OpenStream on SCTP association: %v", err)
 	}
 	log.Printf("OpenStream(%d) when dialing to %q", stream.StreamIdentifier(), addr)
-	return wrapSctpStream(stream, raddr), nil
+	return wrapSctpStream(a.assoc, stream, raddr), nil
 }
 
-func wrapSctpStream(stream *sctp.Stream, raddr net.Addr) net.Conn {
+func wrapSctpStream(assoc *sctp.Association, stream *sctp.Stream, raddr net.Addr) net.Conn {
 	stream.SetBufferedAmountLowThreshold(writeBufferSize)
 	ret := &sctpStreamWrapper{
 		stream:        stream,
 		remoteAddr:    raddr,
+		maxWrite:      int(assoc.MTU()) - sctpOverhead,
 		readerResult:  make(chan error, 1),
 		readDeadline:  deadlinech.New(),
 		pushbackCh:    make(chan struct{}, 1),
@@ -250,10 +366,13 @@ func wrapSctpStream(stream *sctp.Stream, raddr net.Addr) net.Conn {
 type sctpStreamWrapper struct {
 	stream     *sctp.Stream
 	remoteAddr net.Addr
+	// maxWrite is the largest message that fits in a single packet of the association's (probed) path MTU, so
+	// SCTP doesn't have to fragment it.
+	maxWrite int
 
 	readMtx      sync.Mutex
 	readerResult chan error
-	readBuf      [2048]byte // must be at least mtu-28
+	readBuf      [2048]byte // must be at least maxPacketSize-sctpOverhead
 	readable     []byte
 	readDeadline *deadlinech.DeadlineChannel
 
@@ -269,8 +388,8 @@ func (w *sctpStreamWrapper) Write(p []byte) (int, error) {
 	sent := 0
 	for len(p) > 0 {
 		f := p
-		if len(f) > mtu-28 {
-			f = p[:mtu-28]
+		if len(f) > w.maxWrite {
+			f = p[:w.maxWrite]
 		}
 		p = p[len(f):]
 		if err := w.waitForBufferSpace(); err != nil {
@@ -342,6 +461,11 @@ func (w *sctpStreamWrapper) Read(p []byte) (int, error) {
 			// Note that guarantee that we pass in a large enough buffer to read the entire SCTP packet here.
 			n, err := w.stream.Read(w.readBuf[:])
 			w.readable = w.readBuf[:n]
+			if err == io.EOF {
+				// The peer closed the stream. pion/sctp only closes the read direction then, but our streams aren't
+				// half-closable: close the write direction too, so the peer sees EOF as well.
+				w.stream.Close()
+			}
 			w.readerResult <- err
 		}()
 	}
@@ -0,0 +1,76 @@
+package connectivity
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/sgielen/rufs/common"
+	pb "github.com/sgielen/rufs/proto"
+	"github.com/sgielen/rufs/wstunnel"
+)
+
+var (
+	websocketPort = flag.Int("websocket_port", 0, "Port to accept peer connections tunneled over WebSocket on. 0 to disable")
+	websocketURLs = flag.String("websocket_urls", "", "Comma-separated ws:// or wss:// URLs at which peers can reach --websocket_port, for example through a reverse proxy on port 443 (default: our TCP endpoints with --websocket_port)")
+
+	websocketOnce sync.Once
+)
+
+// serveWebsocketTunnel accepts peer connections tunneled over WebSocket, if enabled.
+func serveWebsocketTunnel() {
+	if *websocketPort == 0 {
+		return
+	}
+	addr := fmt.Sprintf(":%d", *websocketPort)
+	tcpAddr, err := net.ResolveTCPAddr("tcp", addr)
+	if err != nil {
+		log.Printf("WebSocket tunnel disabled: %v", err)
+		return
+	}
+	l := wstunnel.NewListener(tcpAddr)
+	mux := http.NewServeMux()
+	mux.Handle(wstunnel.Path, l)
+	go func() {
+		err := http.ListenAndServe(addr, mux)
+		log.Printf("Stopped accepting WebSocket tunnels: %v", err)
+		l.Close()
+	}()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			HandleIncomingContentConnection(conn)
+		}
+	}()
+}
+
+// websocketEndpoints returns the WebSocket endpoints to announce, given our TCP endpoints.
+func websocketEndpoints(tcpEndpoints []*pb.Endpoint) []*pb.Endpoint {
+	if *websocketPort == 0 {
+		return nil
+	}
+	urls := common.SplitMaybeEmpty(*websocketURLs, ",")
+	if len(urls) == 0 {
+		for _, e := range tcpEndpoints {
+			host, _, err := net.SplitHostPort(e.GetAddress())
+			if err != nil {
+				continue
+			}
+			urls = append(urls, "ws://"+net.JoinHostPort(host, fmt.Sprint(*websocketPort))+wstunnel.Path)
+		}
+	}
+	var ret []*pb.Endpoint
+	for _, u := range urls {
+		ret = append(ret, &pb.Endpoint{
+			Type:    pb.Endpoint_WEBSOCKET,
+			Address: u,
+		})
+	}
+	return ret
+}
@@ -0,0 +1,335 @@
+package content
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/sgielen/rufs/client/config"
+	"github.com/sgielen/rufs/common"
+	"github.com/sgielen/rufs/intervals"
+)
+
+// AuditEntry records that a peer read (parts of) a file from our shares.
+type AuditEntry struct {
+	Time time.Time
+	Peer string
+	// Path is the remote path, starting with the share name.
+	Path string
+	// Type is how the data was transferred: "simple", "passive" or "directio".
+	Type   string
+	Ranges []intervals.Interval
+	Bytes  int64
+}
+
+// AuditQuery selects audit entries. Empty fields match everything.
+type AuditQuery struct {
+	Circle string
+	Peer   string
+	// Path matches the path itself and everything below it.
+	Path  string
+	Since time.Time
+	Until time.Time
+	// Limit is the maximum number of entries returned. The newest ones are returned.
+	Limit int
+}
+
+func (q AuditQuery) matches(e AuditEntry) bool {
+	if q.Circle != "" && common.CircleFromPeer(e.Peer) != q.Circle {
+		return false
+	}
+	if q.Peer != "" && e.Peer != q.Peer && common.PeerWithoutDevice(e.Peer) != q.Peer {
+		return false
+	}
+	if q.Path != "" {
+		p := strings.Trim(q.Path, "/")
+		if e.Path != p && !strings.HasPrefix(e.Path, p+"/") {
+			return false
+		}
+	}
+	if !q.Since.IsZero() && e.Time.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && !e.Time.Before(q.Until) {
+		return false
+	}
+	return true
+}
+
+// auditLog is an append-only log of AuditEntries, one JSON object per line. It is rotated to path.<timestamp> when it
+// grows beyond maxSize.
+type auditLog struct {
+	mtx      sync.Mutex
+	path     string
+	disabled bool
+	maxSize  int64
+	maxFiles int
+	maxAge   time.Duration
+	f        *os.File
+	size     int64
+	now      func() time.Time
+}
+
+var audit = &auditLog{now: time.Now}
+
+// ReloadConfig applies the audit log settings from the config.
+func ReloadConfig() error {
+	cfg := config.GetConfig().AuditLog
+	if cfg.MaxSizeMB < 0 || cfg.MaxFiles < 0 || cfg.MaxAgeDays < 0 {
+		return fmt.Errorf("audit_log: settings can't be negative")
+	}
+	audit.configure(config.AuditLogFile(), cfg)
+	return nil
+}
+
+func (a *auditLog) configure(path string, cfg config.AuditLog) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	if a.f != nil && (path != a.path || cfg.Disabled) {
+		a.f.Close()
+		a.f = nil
+	}
+	a.path = path
+	a.disabled = cfg.Disabled
+	a.maxSize = 10 << 20
+	if cfg.MaxSizeMB > 0 {
+		a.maxSize = int64(cfg.MaxSizeMB) << 20
+	}
+	a.maxFiles = 10
+	if cfg.MaxFiles > 0 {
+		a.maxFiles = cfg.MaxFiles
+	}
+	a.maxAge = time.Duration(cfg.MaxAgeDays) * 24 * time.Hour
+	a.expire()
+}
+
+// record appends an entry to the log. Failures are logged, as they shouldn't break transfers.
+func (a *auditLog) record(e AuditEntry) {
+	e.Time = a.now()
+	b, err := json.Marshal(e)
+	if err != nil {
+		log.Printf("Failed to encode audit log entry: %v", err)
+		return
+	}
+	b = append(b, '\n')
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	if a.disabled || a.path == "" {
+		return
+	}
+	if err := a.write(b); err != nil {
+		log.Printf("Failed to write to audit log %q: %v", a.path, err)
+	}
+}
+
+// write appends b to the log, rotating it first if needed. a.mtx must be held.
+func (a *auditLog) write(b []byte) error {
+	if a.f != nil && a.size > 0 && a.size+int64(len(b)) > a.maxSize {
+		a.f.Close()
+		a.f = nil
+		if err := os.Rename(a.path, a.path+"."+a.now().UTC().Format("20060102T150405.000000000")); err != nil {
+			return err
+		}
+		a.expire()
+	}
+	if a.f == nil {
+		f, err := os.OpenFile(a.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+		if err != nil {
+			return err
+		}
+		st, err := f.Stat()
+		if err != nil {
+			f.Close()
+			return err
+		}
+		a.f = f
+		a.size = st.Size()
+	}
+	n, err := a.f.Write(b)
+	a.size += int64(n)
+	return err
+}
+
+// rotated returns the rotated logs, oldest first. a.mtx must be held.
+func (a *auditLog) rotated() []string {
+	fns, _ := filepath.Glob(a.path + ".*")
+	sort.Strings(fns)
+	return fns
+}
+
+// expire deletes rotated logs beyond maxFiles or older than maxAge. a.mtx must be held.
+func (a *auditLog) expire() {
+	if a.path == "" {
+		return
+	}
+	fns := a.rotated()
+	for i, fn := range fns {
+		if i >= len(fns)-a.maxFiles {
+			if a.maxAge == 0 {
+				break
+			}
+			st, err := os.Stat(fn)
+			if err != nil || a.now().Sub(st.ModTime()) < a.maxAge {
+				continue
+			}
+		}
+		if err := os.Remove(fn); err != nil {
+			log.Printf("Failed to delete old audit log: %v", err)
+		}
+	}
+}
+
+// query returns the newest entries matching q, oldest first.
+func (a *auditLog) query(q AuditQuery) ([]AuditEntry, error) {
+	a.mtx.Lock()
+	fns := append(a.rotated(), a.path)
+	a.mtx.Unlock()
+	var ret []AuditEntry
+	for _, fn := range fns {
+		f, err := os.Open(fn)
+		if err != nil {
+			if os.IsNotExist(err) {
+				// Rotated or expired while we were reading.
+				continue
+			}
+			return nil, err
+		}
+		s := bufio.NewScanner(f)
+		s.Buffer(nil, 1<<20)
+		for s.Scan() {
+			var e AuditEntry
+			if err := json.Unmarshal(s.Bytes(), &e); err != nil {
+				// Probably a partial write.
+				continue
+			}
+			if !q.matches(e) {
+				continue
+			}
+			ret = append(ret, e)
+			if q.Limit > 0 && len(ret) > 2*q.Limit {
+				ret = append(ret[:0], ret[len(ret)-q.Limit:]...)
+			}
+		}
+		err = s.Err()
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if q.Limit > 0 && len(ret) > q.Limit {
+		ret = ret[len(ret)-q.Limit:]
+	}
+	return ret, nil
+}
+
+// QueryAuditLog returns the newest audit entries matching q, oldest first.
+func QueryAuditLog(q AuditQuery) ([]AuditEntry, error) {
+	return audit.query(q)
+}
+
+func auditRead(peer, path, typ string, ranges intervals.Intervals) {
+	var total int64
+	for _, iv := range ranges.Export() {
+		total += iv.End - iv.Start
+	}
+	if total == 0 {
+		return
+	}
+	audit.record(AuditEntry{
+		Peer:   peer,
+		Path:   strings.Trim(path, "/"),
+		Type:   typ,
+		Ranges: ranges.Export(),
+		Bytes:  total,
+	})
+}
+
+func auditUpload(circle, peer, remoteFilename string, start, end int64) {
+	var ranges intervals.Intervals
+	ranges.Add(start, end)
+	auditRead(peer, remoteFilename, "passive", ranges)
+}
+
+// auditedFS records which parts of files a peer read through directio. An entry is written when the file is closed.
+type auditedFS struct {
+	billy.Filesystem
+	peer  string
+	share string
+}
+
+func (fs auditedFS) wrap(filename string, f billy.File, err error) (billy.File, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &auditedFile{File: f, peer: fs.peer, path: fs.share + "/" + strings.TrimLeft(filename, "/")}, nil
+}
+
+func (fs auditedFS) Open(filename string) (billy.File, error) {
+	f, err := fs.Filesystem.Open(filename)
+	return fs.wrap(filename, f, err)
+}
+
+func (fs auditedFS) OpenFile(filename string, flag int, perm os.FileMode) (billy.File, error) {
+	f, err := fs.Filesystem.OpenFile(filename, flag, perm)
+	return fs.wrap(filename, f, err)
+}
+
+type auditedFile struct {
+	billy.File
+	peer string
+	path string
+
+	mtx    sync.Mutex
+	offset int64
+	read   intervals.Intervals
+}
+
+func (f *auditedFile) Read(p []byte) (int, error) {
+	n, err := f.File.Read(p)
+	f.mtx.Lock()
+	f.read.Add(f.offset, f.offset+int64(n))
+	f.offset += int64(n)
+	f.mtx.Unlock()
+	return n, err
+}
+
+func (f *auditedFile) ReadAt(p []byte, off int64) (int, error) {
+	n, err := f.File.ReadAt(p, off)
+	f.mtx.Lock()
+	f.read.Add(off, off+int64(n))
+	f.mtx.Unlock()
+	return n, err
+}
+
+func (f *auditedFile) Write(p []byte) (int, error) {
+	n, err := f.File.Write(p)
+	f.mtx.Lock()
+	f.offset += int64(n)
+	f.mtx.Unlock()
+	return n, err
+}
+
+func (f *auditedFile) Seek(offset int64, whence int) (int64, error) {
+	ret, err := f.File.Seek(offset, whence)
+	if err == nil {
+		f.mtx.Lock()
+		f.offset = ret
+		f.mtx.Unlock()
+	}
+	return ret, err
+}
+
+func (f *auditedFile) Close() error {
+	f.mtx.Lock()
+	auditRead(f.peer, f.path, "directio", f.read)
+	f.mtx.Unlock()
+	return f.File.Close()
+}
@@ -0,0 +1,59 @@
+package content
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sgielen/rufs/client/config"
+	"github.com/sgielen/rufs/intervals"
+)
+
+func TestAuditLog(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	a := &auditLog{now: func() time.Time { return now }}
+	a.configure(filepath.Join(t.TempDir(), "audit.log"), config.AuditLog{MaxFiles: 2})
+	// Rotate after every entry.
+	a.maxSize = 1
+
+	record := func(peer, path string, start, end int64) {
+		var ranges intervals.Intervals
+		ranges.Add(start, end)
+		a.record(AuditEntry{Peer: peer, Path: path, Type: "simple", Ranges: ranges.Export(), Bytes: end - start})
+		now = now.Add(time.Minute)
+	}
+	record("alice@example.com", "photos/a.jpg", 0, 100)
+	record("bob+laptop@example.com", "photos/b.jpg", 0, 200)
+	record("bob+phone@example.com", "music/c.mp3", 100, 300)
+	record("carol@other.test", "photos/d.jpg", 0, 400)
+
+	if got := len(a.rotated()); got != 2 {
+		t.Errorf("%d rotated logs are kept; want 2", got)
+	}
+
+	for _, tc := range []struct {
+		q    AuditQuery
+		want []string
+	}{
+		{AuditQuery{}, []string{"photos/b.jpg", "music/c.mp3", "photos/d.jpg"}},
+		{AuditQuery{Peer: "bob@example.com"}, []string{"photos/b.jpg", "music/c.mp3"}},
+		{AuditQuery{Peer: "bob+phone@example.com"}, []string{"music/c.mp3"}},
+		{AuditQuery{Circle: "example.com", Path: "/photos"}, []string{"photos/b.jpg"}},
+		{AuditQuery{Since: time.Date(2020, 1, 1, 12, 2, 0, 0, time.UTC)}, []string{"music/c.mp3", "photos/d.jpg"}},
+		{AuditQuery{Until: time.Date(2020, 1, 1, 12, 2, 0, 0, time.UTC)}, []string{"photos/b.jpg"}},
+		{AuditQuery{Limit: 1}, []string{"photos/d.jpg"}},
+	} {
+		entries, err := a.query(tc.q)
+		if err != nil {
+			t.Fatalf("query(%+v) failed: %v", tc.q, err)
+		}
+		var got []string
+		for _, e := range entries {
+			got = append(got, e.Path)
+		}
+		if diff := cmp.Diff(tc.want, got); diff != "" {
+			t.Errorf("query(%+v) differs (-want +got):\n%s", tc.q, diff)
+		}
+	}
+}
@@ -16,10 +16,14 @@ import (
 	"github.com/Jille/dfr"
 	"github.com/Jille/rpcz"
 	"github.com/go-git/go-billy/v5"
+	"github.com/sgielen/rufs/client/compression"
 	"github.com/sgielen/rufs/client/connectivity"
 	"github.com/sgielen/rufs/client/metrics"
+	"github.com/sgielen/rufs/client/ratelimit"
 	"github.com/sgielen/rufs/client/shares"
+	"github.com/sgielen/rufs/client/transfer"
 	"github.com/sgielen/rufs/client/transfers"
+	"github.com/sgielen/rufs/intervals"
 	pb "github.com/sgielen/rufs/proto"
 	"github.com/sgielen/rufs/security"
 	"google.golang.org/grpc"
@@ -60,11 +64,14 @@ func Serve(addr string, kps []*security.KeyPair) error {
 		}),
 	)
 	pb.RegisterContentServiceServer(s, content{})
+	transfer.UploadedCallback = auditUpload
 	reflection.Register(s)
 	if shares.HasAnyDirectIOShares() {
-		fsserver.RegisterService(s, &directIO{
-			cache: map[string]billy.Filesystem{},
-		})
+		d := &directIO{
+			cache: map[string]map[string]billy.Filesystem{},
+		}
+		shares.RegisterGroupsListener(d.groupsChanged)
+		fsserver.RegisterService(s, d)
 	}
 	sock, err := net.Listen("tcp", addr)
 	if err != nil {
@@ -126,13 +133,13 @@ func streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamS
 }
 
 func (content) ReadDir(ctx context.Context, req *pb.ReadDirRequest) (*pb.ReadDirResponse, error) {
-	_, circle, err := security.PeerFromContext(ctx)
+	peer, circle, err := security.PeerFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	res := &pb.ReadDirResponse{}
-	res.Files, err = shares.Readdir(circle, req.GetPath())
+	res.Files, err = shares.Readdir(circle, peer, req.GetPath())
 	if err != nil {
 		return nil, err
 	}
@@ -174,7 +181,7 @@ func (content) ReadFile(req *pb.ReadFileRequest, stream pb.ContentService_ReadFi
 		return err
 	}
 
-	fh, err := shares.Open(circle, req.GetFilename())
+	fh, err := shares.Open(circle, peer, req.GetFilename())
 	if err != nil {
 		return err
 	}
@@ -212,7 +219,13 @@ func (content) ReadFile(req *pb.ReadFileRequest, stream pb.ContentService_ReadFi
 	}
 
 	var buf [8192]byte
+	comp := compression.Pick(req.GetAcceptCompression())
 	offset := req.GetOffset()
+	defer func() {
+		var sent intervals.Intervals
+		sent.Add(req.GetOffset(), offset)
+		auditRead(peer, req.GetFilename(), "simple", sent)
+	}()
 	remaining := req.GetRdnow()
 	readNowDone := false
 	for {
@@ -232,19 +245,24 @@ func (content) ReadFile(req *pb.ReadFileRequest, stream pb.ContentService_ReadFi
 			return status.Errorf(codes.ResourceExhausted, "failed to read from %q at %d: %v", req.GetFilename(), offset, err)
 		}
 		n := int64(rn)
+		if err := ratelimit.Wait(stream.Context(), peer, rn); err != nil {
+			return err
+		}
+		data, c := compression.Compress(comp, buf[:n])
 		if err := stream.Send(&pb.ReadFileResponse{
-			Offset: offset,
-			Data:   buf[:n],
+			Offset:      offset,
+			Data:        data,
+			Compression: c,
 		}); err != nil {
 			return err
 		}
 		metrics.AddTransferSendBytes([]string{circle}, peer, "simple", n)
+		offset += n
+		remaining -= n
 		if n < r {
 			// Short read, so we hit EOF.
 			return nil
 		}
-		offset += n
-		remaining -= n
 	}
 }
 
@@ -252,6 +270,10 @@ func (content) PassiveTransfer(stream pb.ContentService_PassiveTransferServer) e
 	return transfers.HandleIncomingPassiveTransfer(stream)
 }
 
+func (content) Ping(ctx context.Context, req *pb.PingRequest) (*pb.PingResponse, error) {
+	return &pb.PingResponse{}, nil
+}
+
 type fakeListener struct {
 	ch chan net.Conn
 }
@@ -286,8 +308,9 @@ func RegisterIncomingContentConnectionsServer(l *fakeListener) {
 }
 
 type directIO struct {
-	mtx   sync.Mutex
-	cache map[string]billy.Filesystem
+	mtx sync.Mutex
+	// cache holds the shares granted to each peer.
+	cache map[string]map[string]billy.Filesystem
 }
 
 func (d *directIO) FilesystemForPeer(ctx context.Context) (billy.Filesystem, codes.Code, error) {
@@ -296,15 +319,23 @@ func (d *directIO) FilesystemForPeer(ctx context.Context) (billy.Filesystem, cod
 		return nil, status.Code(err), err
 	}
 	d.mtx.Lock()
-	defer d.mtx.Unlock()
-	if fs, found := d.cache[peer]; found {
-		return fs, codes.OK, nil
+	granted, found := d.cache[peer]
+	if !found {
+		granted = shares.SharesForPeer(peer)
+		d.cache[peer] = granted
 	}
-	granted := shares.SharesForPeer(peer)
+	d.mtx.Unlock()
+	// Files are opened and read within the request, so reads waiting for the upload limit end with it.
 	fs := router.New(emptyfs.New())
 	for name, subfs := range granted {
-		fs.Mount("/"+name, subfs)
+		fs.Mount("/"+name, auditedFS{rateLimitedFS{subfs, ctx, peer}, peer, name})
 	}
-	d.cache[peer] = fs
 	return fs, codes.OK, nil
 }
+
+// groupsChanged forgets which shares peers can write to, because that might depend on their groups.
+func (d *directIO) groupsChanged(circle string) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	d.cache = map[string]map[string]billy.Filesystem{}
+}
@@ -0,0 +1,62 @@
+package content
+
+import (
+	"context"
+	"os"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/sgielen/rufs/client/ratelimit"
+)
+
+// rateLimitedFS applies the upload limits to data read by a peer through directio. Reads give up waiting when ctx,
+// the peer's request, is done.
+type rateLimitedFS struct {
+	billy.Filesystem
+	ctx  context.Context
+	peer string
+}
+
+func (fs rateLimitedFS) wrap(f billy.File, err error) (billy.File, error) {
+	if err != nil {
+		return nil, err
+	}
+	return rateLimitedFile{f, fs.ctx, fs.peer}, nil
+}
+
+func (fs rateLimitedFS) Create(filename string) (billy.File, error) {
+	return fs.wrap(fs.Filesystem.Create(filename))
+}
+
+func (fs rateLimitedFS) Open(filename string) (billy.File, error) {
+	return fs.wrap(fs.Filesystem.Open(filename))
+}
+
+func (fs rateLimitedFS) OpenFile(filename string, flag int, perm os.FileMode) (billy.File, error) {
+	return fs.wrap(fs.Filesystem.OpenFile(filename, flag, perm))
+}
+
+func (fs rateLimitedFS) TempFile(dir, prefix string) (billy.File, error) {
+	return fs.wrap(fs.Filesystem.TempFile(dir, prefix))
+}
+
+type rateLimitedFile struct {
+	billy.File
+	ctx  context.Context
+	peer string
+}
+
+func (f rateLimitedFile) Read(p []byte) (int, error) {
+	n, err := f.File.Read(p)
+	if werr := ratelimit.Wait(f.ctx, f.peer, n); werr != nil {
+		return 0, werr
+	}
+	return n, err
+}
+
+func (f rateLimitedFile) ReadAt(p []byte, off int64) (int, error) {
+	n, err := f.File.ReadAt(p, off)
+	if werr := ratelimit.Wait(f.ctx, f.peer, n); werr != nil {
+		return 0, werr
+	}
+	return n, err
+}
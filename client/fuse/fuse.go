@@ -92,7 +92,8 @@ func (f *Mount) Run(ctx context.Context) (retErr error) {
 			retErr = err
 		}
 	}()
-	if err := fs.Serve(conn, billybazilfuse.New(vfs.GetFilesystem(), f.callHook)); err != nil {
+	filesystem := vfs.GetFilesystem()
+	if err := fs.Serve(conn, metadataFS{billybazilfuse.New(filesystem, f.callHook), filesystem, f.callHook}); err != nil {
 		return err
 	}
 	<-conn.Ready
@@ -51,7 +51,8 @@ type Mount struct {
 }
 
 func (f *Mount) Run(ctx context.Context) (retErr error) {
-	host := fuse.NewFileSystemHost(billycgofuse.New(vfs.GetFilesystem()))
+	filesystem := vfs.GetFilesystem()
+	host := fuse.NewFileSystemHost(metadataFS{billycgofuse.New(filesystem), filesystem})
 	host.SetCapCaseInsensitive(false)
 	host.SetCapReaddirPlus(true)
 	// TODO: small readahead, allow others if len(f.allowedUsers) != 0
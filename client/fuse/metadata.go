@@ -0,0 +1,122 @@
+// +build !windows,!darwin,!cgofuse
+
+package fuse
+
+import (
+	"context"
+	"path"
+
+	"bazil.org/fuse"
+	"bazil.org/fuse/fs"
+	billybazilfuse "github.com/Jille/billy-bazilfuse"
+	"github.com/go-git/go-billy/v5"
+)
+
+// metadataFS adds extended attributes to the nodes of billybazilfuse, which doesn't support them.
+type metadataFS struct {
+	fs.FS
+	underlying billy.Filesystem
+	callHook   billybazilfuse.CallHook
+}
+
+func (m metadataFS) Root() (fs.Node, error) {
+	n, err := m.FS.Root()
+	if err != nil {
+		return nil, err
+	}
+	return m.wrap(n, ""), nil
+}
+
+func (m metadataFS) wrap(n fs.Node, p string) fs.Node {
+	return &metadataNode{n.(bazilNode), m, p}
+}
+
+// bazilNode are the interfaces implemented by the nodes of billybazilfuse.
+type bazilNode interface {
+	fs.Node
+	fs.NodeCreater
+	fs.NodeMkdirer
+	fs.NodeOpener
+	fs.NodeReadlinker
+	fs.NodeRemover
+	fs.NodeRenamer
+	fs.NodeRequestLookuper
+	fs.NodeSymlinker
+	fs.NodeSetattrer
+}
+
+type metadataNode struct {
+	bazilNode
+	fs   metadataFS
+	path string
+}
+
+var _ fs.NodeGetxattrer = &metadataNode{}
+var _ fs.NodeListxattrer = &metadataNode{}
+
+func (n *metadataNode) Lookup(ctx context.Context, req *fuse.LookupRequest, resp *fuse.LookupResponse) (fs.Node, error) {
+	c, err := n.bazilNode.Lookup(ctx, req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return n.fs.wrap(c, path.Join(n.path, req.Name)), nil
+}
+
+func (n *metadataNode) Create(ctx context.Context, req *fuse.CreateRequest, resp *fuse.CreateResponse) (fs.Node, fs.Handle, error) {
+	c, h, err := n.bazilNode.Create(ctx, req, resp)
+	if err != nil {
+		return nil, nil, err
+	}
+	return n.fs.wrap(c, path.Join(n.path, req.Name)), h, nil
+}
+
+func (n *metadataNode) Mkdir(ctx context.Context, req *fuse.MkdirRequest) (fs.Node, error) {
+	c, err := n.bazilNode.Mkdir(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return n.fs.wrap(c, path.Join(n.path, req.Name)), nil
+}
+
+func (n *metadataNode) Symlink(ctx context.Context, req *fuse.SymlinkRequest) (fs.Node, error) {
+	c, err := n.bazilNode.Symlink(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return n.fs.wrap(c, path.Join(n.path, req.NewName)), nil
+}
+
+func (n *metadataNode) Rename(ctx context.Context, req *fuse.RenameRequest, newDir fs.Node) error {
+	if m, ok := newDir.(*metadataNode); ok {
+		newDir = m.bazilNode
+	}
+	return n.bazilNode.Rename(ctx, req, newDir)
+}
+
+func (n *metadataNode) Getxattr(ctx context.Context, req *fuse.GetxattrRequest, resp *fuse.GetxattrResponse) error {
+	if err := n.fs.callHook(ctx, req); err != nil {
+		return err
+	}
+	xattrs, err := getXattrs(n.fs.underlying, n.path)
+	if err != nil {
+		return fuse.ENOENT
+	}
+	v, ok := xattrs[req.Name]
+	if !ok {
+		return fuse.ErrNoXattr
+	}
+	resp.Xattr = v
+	return nil
+}
+
+func (n *metadataNode) Listxattr(ctx context.Context, req *fuse.ListxattrRequest, resp *fuse.ListxattrResponse) error {
+	if err := n.fs.callHook(ctx, req); err != nil {
+		return err
+	}
+	xattrs, err := getXattrs(n.fs.underlying, n.path)
+	if err != nil {
+		return fuse.ENOENT
+	}
+	resp.Append(sortedNames(xattrs)...)
+	return nil
+}
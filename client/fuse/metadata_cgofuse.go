@@ -0,0 +1,58 @@
+// +build windows darwin cgofuse
+
+package fuse
+
+import (
+	"time"
+
+	"github.com/billziss-gh/cgofuse/fuse"
+	"github.com/go-git/go-billy/v5"
+)
+
+// birthTimer is implemented by vfs.File.
+type birthTimer interface {
+	BirthTime() time.Time
+}
+
+// metadataFS adds creation times and extended attributes, which billycgofuse doesn't pass on.
+type metadataFS struct {
+	fuse.FileSystemInterface
+	underlying billy.Filesystem
+}
+
+func (m metadataFS) Getattr(path string, stat *fuse.Stat_t, fh uint64) int {
+	if ret := m.FileSystemInterface.Getattr(path, stat, fh); ret != 0 {
+		return ret
+	}
+	if fi, err := m.underlying.Stat(path); err == nil {
+		if bt, ok := fi.(birthTimer); ok && !bt.BirthTime().IsZero() {
+			stat.Birthtim = fuse.NewTimespec(bt.BirthTime())
+		}
+	}
+	return 0
+}
+
+func (m metadataFS) Getxattr(path string, name string) (int, []byte) {
+	xattrs, err := getXattrs(m.underlying, path)
+	if err != nil {
+		return -fuse.ENOENT, nil
+	}
+	v, ok := xattrs[name]
+	if !ok {
+		return -fuse.ENOATTR, nil
+	}
+	return 0, v
+}
+
+func (m metadataFS) Listxattr(path string, fill func(name string) bool) int {
+	xattrs, err := getXattrs(m.underlying, path)
+	if err != nil {
+		return -fuse.ENOENT
+	}
+	for _, name := range sortedNames(xattrs) {
+		if !fill(name) {
+			return -fuse.ERANGE
+		}
+	}
+	return 0
+}
@@ -0,0 +1,34 @@
+package fuse
+
+import (
+	"sort"
+
+	"github.com/go-git/go-billy/v5"
+)
+
+// xattrer is implemented by vfs.File.
+type xattrer interface {
+	Xattrs() map[string][]byte
+}
+
+// getXattrs returns the extended attributes of p.
+func getXattrs(fs billy.Filesystem, p string) (map[string][]byte, error) {
+	fi, err := fs.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+	if x, ok := fi.(xattrer); ok {
+		return x.Xattrs(), nil
+	}
+	return nil, nil
+}
+
+// sortedNames returns the names of xattrs in a stable order.
+func sortedNames(xattrs map[string][]byte) []string {
+	ret := make([]string, 0, len(xattrs))
+	for name := range xattrs {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
@@ -0,0 +1,126 @@
+// Package proxy dials outbound TCP connections through a SOCKS5 or HTTP CONNECT proxy.
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+
+	xproxy "golang.org/x/net/proxy"
+)
+
+var globalProxy = flag.String("proxy", "", "Proxy for outbound connections: socks5://[user:pass@]host:port or http://[user:pass@]host:port. Circles can override it with `proxy` in config.yaml")
+
+// DialFunc dials a connection, like net.Dialer.DialContext.
+type DialFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// Resolve returns the proxy URL to use given the setting of a circle: the circle's own proxy, or --proxy if it doesn't
+// have one. "direct" disables the proxy. It returns "" if no proxy should be used.
+func Resolve(circleProxy string) string {
+	if circleProxy == "" {
+		circleProxy = *globalProxy
+	}
+	if circleProxy == "direct" {
+		return ""
+	}
+	return circleProxy
+}
+
+// Redact returns proxyURL without its password, for logging.
+func Redact(proxyURL string) string {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return "(invalid)"
+	}
+	return u.Redacted()
+}
+
+// Dialer returns a DialFunc that connects through proxyURL, or directly if it's empty.
+func Dialer(proxyURL string) (DialFunc, error) {
+	if proxyURL == "" {
+		var d net.Dialer
+		return d.DialContext, nil
+	}
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy %q: %v", proxyURL, err)
+	}
+	switch u.Scheme {
+	case "socks5", "socks5h":
+		var auth *xproxy.Auth
+		if u.User != nil {
+			pass, _ := u.User.Password()
+			auth = &xproxy.Auth{User: u.User.Username(), Password: pass}
+		}
+		d, err := xproxy.SOCKS5("tcp", u.Host, auth, &net.Dialer{})
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy %q: %v", proxyURL, err)
+		}
+		return d.(xproxy.ContextDialer).DialContext, nil
+	case "http":
+		return func(ctx context.Context, network, addr string) (net.Conn, error) {
+			return dialHTTPConnect(ctx, u, addr)
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme %q (use socks5 or http)", u.Scheme)
+	}
+}
+
+// dialHTTPConnect sets up a tunnel to addr with an HTTP CONNECT request to the proxy.
+func dialHTTPConnect(ctx context.Context, proxy *url.URL, addr string) (net.Conn, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", proxy.Host)
+	if err != nil {
+		return nil, err
+	}
+	// Abort the handshake if ctx expires.
+	stop := context.AfterFunc(ctx, func() {
+		conn.Close()
+	})
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: http.Header{},
+	}
+	if proxy.User != nil {
+		pass, _ := proxy.User.Password()
+		req.Header.Set("Proxy-Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(proxy.User.Username()+":"+pass)))
+	}
+	br := bufio.NewReader(conn)
+	err = req.Write(conn)
+	var resp *http.Response
+	if err == nil {
+		resp, err = http.ReadResponse(br, req)
+	}
+	if !stop() {
+		conn.Close()
+		return nil, ctx.Err()
+	}
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("proxy handshake failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy refused to connect to %s: %s", addr, resp.Status)
+	}
+	return &bufferedConn{Conn: conn, r: br}, nil
+}
+
+// bufferedConn reads through the bufio.Reader that was used to read the proxy's response, in case it already
+// buffered data of the connection.
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *bufferedConn) Read(p []byte) (int, error) {
+	return c.r.Read(p)
+}
@@ -0,0 +1,49 @@
+package ratelimit
+
+import (
+	"fmt"
+
+	"github.com/sgielen/rufs/client/config"
+)
+
+// ReloadConfig applies the bandwidth limits from the config.
+func ReloadConfig() error {
+	cfg := config.GetConfig()
+	var limits Limits
+	var err error
+	limits.Global, err = ParseRate(cfg.UploadLimit)
+	if err != nil {
+		return fmt.Errorf("upload_limit: %v", err)
+	}
+	limits.Download, err = ParseRate(cfg.DownloadLimit)
+	if err != nil {
+		return fmt.Errorf("download_limit: %v", err)
+	}
+	for _, w := range cfg.UploadSchedule {
+		var window Window
+		if window.From, err = ParseTimeOfDay(w.From); err != nil {
+			return fmt.Errorf("upload_schedule: %v", err)
+		}
+		if window.To, err = ParseTimeOfDay(w.To); err != nil {
+			return fmt.Errorf("upload_schedule: %v", err)
+		}
+		if window.Rate, err = ParseRate(w.Limit); err != nil {
+			return fmt.Errorf("upload_schedule: %v", err)
+		}
+		limits.Schedule = append(limits.Schedule, window)
+	}
+	limits.Circles = map[string]int64{}
+	limits.Peers = map[string]int64{}
+	for _, c := range cfg.Circles {
+		if limits.Circles[c.Name], err = ParseRate(c.UploadLimit); err != nil {
+			return fmt.Errorf("upload_limit of circle %s: %v", c.Name, err)
+		}
+		for peer, l := range c.PeerUploadLimits {
+			if limits.Peers[peer], err = ParseRate(l); err != nil {
+				return fmt.Errorf("upload limit for %s: %v", peer, err)
+			}
+		}
+	}
+	SetLimits(limits)
+	return nil
+}
@@ -0,0 +1,188 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Priority is the class of a download. Lower values go first.
+type Priority int
+
+const (
+	// Interactive downloads are data a read() is blocked on.
+	Interactive Priority = iota
+	// Readahead downloads are data we expect to be read soon.
+	Readahead
+	// Background downloads are data nobody is waiting for.
+	Background
+	numPriorities
+)
+
+func (p Priority) String() string {
+	switch p {
+	case Interactive:
+		return "interactive"
+	case Readahead:
+		return "readahead"
+	case Background:
+		return "background"
+	default:
+		return "unknown"
+	}
+}
+
+// scheduler hands out download bandwidth by priority. A download only gets bandwidth if no download of another owner
+// with a higher priority is pending, even when downloads are unlimited. Owners are transfers: the data a transfer is
+// waiting for might be queued behind its own lower priority data on the same stream, so those don't wait for each other.
+type scheduler struct {
+	mtx     sync.Mutex
+	cond    *sync.Cond
+	bucket  *bucket
+	pending [numPriorities]map[interface{}]int
+	// gen is incremented whenever pending changes.
+	gen int
+}
+
+func newScheduler() *scheduler {
+	s := &scheduler{bucket: newBucket(0)}
+	s.cond = sync.NewCond(&s.mtx)
+	for p := range s.pending {
+		s.pending[p] = map[interface{}]int{}
+	}
+	return s
+}
+
+// higherPending returns whether downloads of other owners with a higher priority than prio are pending. s.mtx must
+// be held.
+func (s *scheduler) higherPending(owner interface{}, prio Priority) bool {
+	for p := Interactive; p < prio; p++ {
+		for o := range s.pending[p] {
+			if o != owner {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// addPending changes the number of pending downloads of owner with priority prio. s.mtx must be held.
+func (s *scheduler) addPending(owner interface{}, prio Priority, delta int) {
+	s.pending[prio][owner] += delta
+	if s.pending[prio][owner] <= 0 {
+		delete(s.pending[prio], owner)
+	}
+	// Waiters re-evaluate their priority, as it might have changed along with ours.
+	s.gen++
+	s.cond.Broadcast()
+}
+
+// notPending is the priority of a download that isn't pending yet.
+const notPending Priority = -1
+
+// updatePriority makes owner's download pending with the priority returned by prio instead of *cur. prio is called
+// without s.mtx held, and again if pending changed in the meantime, as that might have changed its outcome. s.mtx
+// must be held.
+func (s *scheduler) updatePriority(owner interface{}, cur *Priority, prio func() Priority) {
+	for {
+		gen := s.gen
+		s.mtx.Unlock()
+		p := prio()
+		s.mtx.Lock()
+		changed := s.gen != gen
+		if p != *cur {
+			if *cur != notPending {
+				s.addPending(owner, *cur, -1)
+			}
+			s.addPending(owner, p, 1)
+			*cur = p
+		}
+		if !changed {
+			return
+		}
+	}
+}
+
+// acquire blocks until n bytes may be downloaded for owner. The download is pending, and makes downloads of lower
+// priorities wait, until release is called. n may be 0 for downloads that don't need bandwidth. prio is called again
+// whenever the download has to wait, so that a download can be upgraded while it waits.
+func (s *scheduler) acquire(ctx context.Context, owner interface{}, prio func() Priority, n int) (release func(), err error) {
+	stop := context.AfterFunc(ctx, func() {
+		s.mtx.Lock()
+		defer s.mtx.Unlock()
+		s.cond.Broadcast()
+	})
+	defer stop()
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	cur := notPending
+	s.updatePriority(owner, &cur, prio)
+	if err := s.reserve(ctx, owner, &cur, prio, n); err != nil {
+		s.addPending(owner, cur, -1)
+		return nil, err
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.mtx.Lock()
+			defer s.mtx.Unlock()
+			s.addPending(owner, cur, -1)
+		})
+	}, nil
+}
+
+// reserve waits for higher priorities and the bucket to allow n bytes. *cur is the priority the download is pending
+// with, and is updated from prio while waiting. s.mtx must be held.
+func (s *scheduler) reserve(ctx context.Context, owner interface{}, cur *Priority, prio func() Priority, n int) error {
+	for {
+		for s.higherPending(owner, *cur) {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			s.cond.Wait()
+			s.updatePriority(owner, cur, prio)
+		}
+		if n <= 0 {
+			return nil
+		}
+		c := n
+		if c > chunkSize {
+			c = chunkSize
+		}
+		delay := s.bucket.reserve(c)
+		if delay > 0 {
+			// We stay pending while we sleep, so that lower priorities don't take the bandwidth.
+			s.mtx.Unlock()
+			t := time.NewTimer(delay)
+			select {
+			case <-t.C:
+			case <-ctx.Done():
+				t.Stop()
+				s.bucket.unreserve(c)
+				s.mtx.Lock()
+				return ctx.Err()
+			}
+			s.mtx.Lock()
+		}
+		n -= c
+	}
+}
+
+var downloads = newScheduler()
+
+// AcquireDownload blocks until n bytes may be downloaded for owner. The download makes downloads of other owners with
+// lower priorities wait until release is called. n may be 0 for downloads that don't need bandwidth, like a read that
+// waits for data. prio returns the download's priority, and is called again whenever the download has to wait.
+func AcquireDownload(ctx context.Context, owner interface{}, prio func() Priority, n int) (release func(), err error) {
+	return downloads.acquire(ctx, owner, prio, n)
+}
+
+// WaitDownload blocks until n bytes may be downloaded for owner, for data that doesn't stay pending afterwards.
+func WaitDownload(ctx context.Context, owner interface{}, prio func() Priority, n int) error {
+	release, err := downloads.acquire(ctx, owner, prio, n)
+	if err != nil {
+		return err
+	}
+	release()
+	return nil
+}
@@ -0,0 +1,285 @@
+// Package ratelimit limits how fast we send data to peers, with token buckets for all our uploads, per circle and per
+// peer. It also limits how fast we download, and gives interactive downloads precedence over others.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sgielen/rufs/common"
+)
+
+// chunkSize is the largest amount of data that is waited for at once. Larger writes are split up, so that concurrent
+// readers take turns.
+const chunkSize = 16384
+
+// bucket is a token bucket that is refilled with rate bytes per second. Reservations can make the bucket go into
+// debt, which later reservations have to wait for. That serves waiters in the order they arrived.
+type bucket struct {
+	mtx    sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// newBucket returns a bucket that allows rate bytes per second. A rate of zero means unlimited.
+func newBucket(rate int64) *bucket {
+	b := &bucket{now: time.Now}
+	b.setRate(rate)
+	return b
+}
+
+// setRate changes the rate of the bucket. A rate of zero means unlimited.
+func (b *bucket) setRate(rate int64) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	now := b.now()
+	if float64(rate) == b.rate {
+		return
+	}
+	b.refill(now)
+	if b.rate <= 0 {
+		// We weren't keeping track, so start with a full bucket.
+		b.tokens = float64(rate)
+	}
+	b.rate = float64(rate)
+	// Allow a tenth of a second of data in one go, but at least a chunk.
+	b.burst = b.rate / 10
+	if b.burst < chunkSize {
+		b.burst = chunkSize
+	}
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+}
+
+func (b *bucket) refill(now time.Time) {
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+}
+
+// reserve takes n tokens and returns how long the caller has to wait before it can use them.
+func (b *bucket) reserve(n int) time.Duration {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if b.rate <= 0 {
+		return 0
+	}
+	b.refill(b.now())
+	b.tokens -= float64(n)
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// unreserve returns n tokens to the bucket, for when a reservation wasn't used.
+func (b *bucket) unreserve(n int) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if b.rate <= 0 {
+		return
+	}
+	b.tokens += float64(n)
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
+
+// wait blocks until n bytes may be sent according to all buckets.
+func wait(ctx context.Context, n int, buckets ...*bucket) error {
+	for n > 0 {
+		c := n
+		if c > chunkSize {
+			c = chunkSize
+		}
+		var delay time.Duration
+		for _, b := range buckets {
+			if d := b.reserve(c); d > delay {
+				delay = d
+			}
+		}
+		if delay > 0 {
+			t := time.NewTimer(delay)
+			select {
+			case <-t.C:
+			case <-ctx.Done():
+				t.Stop()
+				for _, b := range buckets {
+					b.unreserve(c)
+				}
+				return ctx.Err()
+			}
+		}
+		n -= c
+	}
+	return nil
+}
+
+// Window overrides the global limit between From and To, which are durations since midnight. If To is before From,
+// the window wraps around midnight.
+type Window struct {
+	From time.Duration
+	To   time.Duration
+	Rate int64
+}
+
+func (w Window) contains(t time.Duration) bool {
+	if w.From <= w.To {
+		return w.From <= t && t < w.To
+	}
+	return t >= w.From || t < w.To
+}
+
+// Limits are bandwidth limits in bytes per second. Zero means unlimited.
+type Limits struct {
+	// Download limits all downloads together.
+	Download int64
+	// Global limits all uploads together.
+	Global int64
+	// Schedule overrides Global at certain times of the day. The first matching window is used.
+	Schedule []Window
+	Circles  map[string]int64
+	// Peers can have limits for a specific device (user+device@circle) or for a user (user@circle), which is shared by
+	// all their devices.
+	Peers map[string]int64
+}
+
+func (l Limits) globalRate(now time.Time) int64 {
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	sinceMidnight := now.Sub(midnight)
+	for _, w := range l.Schedule {
+		if w.contains(sinceMidnight) {
+			return w.Rate
+		}
+	}
+	return l.Global
+}
+
+// limiter applies Limits.
+type limiter struct {
+	mtx     sync.Mutex
+	limits  Limits
+	global  *bucket
+	circles map[string]*bucket
+	peers   map[string]*bucket
+	now     func() time.Time
+}
+
+func newLimiter() *limiter {
+	return &limiter{
+		global:  newBucket(0),
+		circles: map[string]*bucket{},
+		peers:   map[string]*bucket{},
+		now:     time.Now,
+	}
+}
+
+// setLimits replaces the limits.
+func (l *limiter) setLimits(limits Limits) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.limits = limits
+	l.circles = map[string]*bucket{}
+	l.peers = map[string]*bucket{}
+}
+
+// buckets returns the buckets that apply to peer.
+func (l *limiter) buckets(peer string) []*bucket {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.global.setRate(l.limits.globalRate(l.now()))
+	ret := []*bucket{l.global}
+	circle := common.CircleFromPeer(peer)
+	if rate := l.limits.Circles[circle]; rate > 0 {
+		b, ok := l.circles[circle]
+		if !ok {
+			b = newBucket(rate)
+			l.circles[circle] = b
+		}
+		ret = append(ret, b)
+	}
+	key := peer
+	rate, ok := l.limits.Peers[key]
+	if !ok {
+		key = common.PeerWithoutDevice(peer)
+		rate = l.limits.Peers[key]
+	}
+	if rate > 0 {
+		b, ok := l.peers[key]
+		if !ok {
+			b = newBucket(rate)
+			l.peers[key] = b
+		}
+		ret = append(ret, b)
+	}
+	return ret
+}
+
+// wait blocks until n bytes may be sent to peer.
+func (l *limiter) wait(ctx context.Context, peer string, n int) error {
+	return wait(ctx, n, l.buckets(peer)...)
+}
+
+var std = newLimiter()
+
+// SetLimits replaces the limits used by Wait and WaitDownload.
+func SetLimits(limits Limits) {
+	std.setLimits(limits)
+	downloads.bucket.setRate(limits.Download)
+}
+
+// Wait blocks until n bytes may be sent to peer.
+func Wait(ctx context.Context, peer string, n int) error {
+	return std.wait(ctx, peer, n)
+}
+
+// ParseRate parses a rate in bytes per second, like "500KB", "2MiB/s" or "100000". Decimal (KB, MB, GB) and binary
+// (KiB, MiB, GiB) units are supported. An empty string means unlimited (zero).
+func ParseRate(s string) (int64, error) {
+	v := strings.TrimSuffix(strings.TrimSpace(s), "/s")
+	if v == "" {
+		return 0, nil
+	}
+	units := []struct {
+		suffix string
+		mult   float64
+	}{
+		{"KiB", 1 << 10}, {"MiB", 1 << 20}, {"GiB", 1 << 30},
+		{"KB", 1e3}, {"MB", 1e6}, {"GB", 1e9},
+		{"K", 1e3}, {"M", 1e6}, {"G", 1e9},
+		{"B", 1},
+	}
+	mult := 1.0
+	for _, u := range units {
+		if strings.HasSuffix(v, u.suffix) {
+			v = strings.TrimSpace(strings.TrimSuffix(v, u.suffix))
+			mult = u.mult
+			break
+		}
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || f < 0 {
+		return 0, fmt.Errorf("invalid rate %q", s)
+	}
+	return int64(f * mult), nil
+}
+
+// ParseTimeOfDay parses "15:04" into a duration since midnight.
+func ParseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q (want HH:MM)", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
@@ -0,0 +1,295 @@
+package ratelimit
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.t
+}
+
+func TestBucket(t *testing.T) {
+	clock := &fakeClock{t: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)}
+	b := &bucket{now: clock.now}
+	b.setRate(1000000)
+
+	// The bucket starts with a burst of a tenth of a second.
+	if d := b.reserve(100000); d != 0 {
+		t.Errorf("reserve(100000) on a full bucket = %v; want 0", d)
+	}
+	// Waiters queue up behind each other.
+	if d := b.reserve(100000); d != 100*time.Millisecond {
+		t.Errorf("first reserve(100000) on an empty bucket = %v; want 100ms", d)
+	}
+	if d := b.reserve(100000); d != 200*time.Millisecond {
+		t.Errorf("second reserve(100000) on an empty bucket = %v; want 200ms", d)
+	}
+	clock.t = clock.t.Add(200 * time.Millisecond)
+	if d := b.reserve(50000); d != 50*time.Millisecond {
+		t.Errorf("reserve(50000) after paying off the debt = %v; want 50ms", d)
+	}
+	// Idle time doesn't allow more than a burst.
+	clock.t = clock.t.Add(time.Hour)
+	if d := b.reserve(200000); d != 100*time.Millisecond {
+		t.Errorf("reserve(200000) after an hour = %v; want 100ms", d)
+	}
+
+	b.setRate(0)
+	if d := b.reserve(1 << 30); d != 0 {
+		t.Errorf("reserve() on an unlimited bucket = %v; want 0", d)
+	}
+}
+
+func TestWaitCancel(t *testing.T) {
+	clock := &fakeClock{t: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)}
+	b := &bucket{now: clock.now}
+	b.setRate(chunkSize)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := wait(ctx, chunkSize, b); err != nil {
+		t.Fatalf("wait() for the burst failed: %v", err)
+	}
+	if err := wait(ctx, chunkSize, b); err != context.Canceled {
+		t.Fatalf("wait() with a cancelled context = %v; want %v", err, context.Canceled)
+	}
+	// The cancelled reservation was returned.
+	if d := b.reserve(chunkSize); d != time.Second {
+		t.Errorf("reserve() after a cancelled wait = %v; want 1s", d)
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	clock := &fakeClock{t: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)}
+	l := newLimiter()
+	l.now = clock.now
+	l.setLimits(Limits{
+		Global: 1000,
+		Schedule: []Window{
+			{From: 22 * time.Hour, To: 7 * time.Hour, Rate: 0},
+			{From: 9 * time.Hour, To: 17 * time.Hour, Rate: 500},
+		},
+		Circles: map[string]int64{"example.com": 100},
+		Peers: map[string]int64{
+			"alice@example.com":        10,
+			"bob+laptop@example.com":   20,
+			"carol+desktop@other.test": 30,
+		},
+	})
+
+	rates := func(peer string) []float64 {
+		var ret []float64
+		for _, b := range l.buckets(peer) {
+			ret = append(ret, b.rate)
+		}
+		return ret
+	}
+	for _, tc := range []struct {
+		hour int
+		peer string
+		want []float64
+	}{
+		{12, "alice+phone@example.com", []float64{500, 100, 10}},
+		{12, "bob+laptop@example.com", []float64{500, 100, 20}},
+		{12, "bob+phone@example.com", []float64{500, 100}},
+		{18, "carol+desktop@other.test", []float64{1000, 30}},
+		{23, "dave@other.test", []float64{0}},
+		{3, "dave@other.test", []float64{0}},
+		{7, "dave@other.test", []float64{1000}},
+	} {
+		clock.t = time.Date(2020, 1, 1, tc.hour, 0, 0, 0, time.UTC)
+		got := rates(tc.peer)
+		if diff := cmp.Diff(tc.want, got); diff != "" {
+			t.Errorf("at %d:00, rates for %s differ (-want +got):\n%s", tc.hour, tc.peer, diff)
+		}
+	}
+
+	// Devices of a user share the user's bucket.
+	a, b := l.buckets("alice+phone@example.com"), l.buckets("alice+laptop@example.com")
+	if a[2] != b[2] {
+		t.Errorf("devices of alice got different buckets")
+	}
+}
+
+func TestParseRate(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want int64
+	}{
+		{"", 0},
+		{"12345", 12345},
+		{"500KB", 500000},
+		{"500 KB", 500000},
+		{"2MiB/s", 2 << 20},
+		{"1.5M", 1500000},
+		{"1G", 1000000000},
+		{"100B", 100},
+	} {
+		got, err := ParseRate(tc.in)
+		if err != nil {
+			t.Errorf("ParseRate(%q) failed: %v", tc.in, err)
+		} else if got != tc.want {
+			t.Errorf("ParseRate(%q) = %d; want %d", tc.in, got, tc.want)
+		}
+	}
+	for _, in := range []string{"fast", "-1KB", "KB"} {
+		if _, err := ParseRate(in); err == nil {
+			t.Errorf("ParseRate(%q) succeeded; want error", in)
+		}
+	}
+}
+
+func TestSchedulerUnlimited(t *testing.T) {
+	s := newScheduler()
+	for p := Interactive; p < numPriorities; p++ {
+		release, err := s.acquire(context.Background(), "a", fixed(p), 1<<20)
+		if err != nil {
+			t.Fatalf("acquire(%s) without a limit failed: %v", p, err)
+		}
+		release()
+	}
+}
+
+// fixed returns a priority function for a download whose priority doesn't change.
+func fixed(p Priority) func() Priority {
+	return func() Priority {
+		return p
+	}
+}
+
+// waitPending waits until owner has a pending download of priority prio.
+func waitPending(s *scheduler, owner string, prio Priority) {
+	for {
+		s.mtx.Lock()
+		n := s.pending[prio][owner]
+		s.mtx.Unlock()
+		if n > 0 {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSchedulerPriority(t *testing.T) {
+	s := newScheduler()
+	s.bucket.setRate(chunkSize * 10)
+	ctx := context.Background()
+	// Use up the burst, so that the next interactive download has to sleep.
+	release, err := s.acquire(ctx, "a", fixed(Interactive), int(s.bucket.burst))
+	if err != nil {
+		t.Fatalf("acquire() for the burst failed: %v", err)
+	}
+	release()
+
+	done := make(chan Priority, 2)
+	go func() {
+		release, _ := s.acquire(ctx, "a", fixed(Interactive), chunkSize)
+		release()
+		done <- Interactive
+	}()
+	waitPending(s, "a", Interactive)
+	go func() {
+		release, _ := s.acquire(ctx, "b", fixed(Background), chunkSize)
+		release()
+		done <- Background
+	}()
+	if p := <-done; p != Interactive {
+		t.Errorf("%s download finished first; want %s", p, Interactive)
+	}
+	<-done
+
+	// A cancelled background download doesn't wait behind interactive ones.
+	s.mtx.Lock()
+	s.addPending("a", Interactive, 1)
+	s.mtx.Unlock()
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := s.acquire(cctx, "b", fixed(Background), chunkSize); err != context.Canceled {
+		t.Errorf("acquire() with a cancelled context = %v; want %v", err, context.Canceled)
+	}
+}
+
+func TestSchedulerPending(t *testing.T) {
+	s := newScheduler()
+	ctx := context.Background()
+	// A read of "a" is blocked on data, which doesn't need bandwidth.
+	release, err := s.acquire(ctx, "a", fixed(Interactive), 0)
+	if err != nil {
+		t.Fatalf("acquire() failed: %v", err)
+	}
+
+	// Readahead of the same transfer isn't held up, as the data might be queued behind it.
+	own, err := s.acquire(ctx, "a", fixed(Readahead), chunkSize)
+	if err != nil {
+		t.Fatalf("acquire() of the same owner failed: %v", err)
+	}
+	own()
+
+	// Readahead of other transfers waits, even without a limit.
+	done := make(chan struct{})
+	go func() {
+		r, _ := s.acquire(ctx, "b", fixed(Readahead), chunkSize)
+		r()
+		close(done)
+	}()
+	waitPending(s, "b", Readahead)
+	select {
+	case <-done:
+		t.Fatalf("readahead of another owner didn't wait for the pending interactive download")
+	case <-time.After(10 * time.Millisecond):
+	}
+	release()
+	<-done
+}
+
+func TestSchedulerUpgrade(t *testing.T) {
+	s := newScheduler()
+	ctx := context.Background()
+
+	// Transfers a and b are each read sequentially. Each has a read blocked on data, and a fetcher that started
+	// downloading the next range as readahead and waits for the other transfer's read.
+	var aPrio, bPrio atomic.Int64
+	aPrio.Store(int64(Readahead))
+	bPrio.Store(int64(Readahead))
+	fetch := func(owner string, prio *atomic.Int64, done chan<- struct{}) {
+		r, err := s.acquire(ctx, owner, func() Priority { return Priority(prio.Load()) }, chunkSize)
+		if err == nil {
+			r()
+		}
+		close(done)
+	}
+	relB, _ := s.acquire(ctx, "b", fixed(Interactive), 0)
+	defer relB()
+	aDone := make(chan struct{})
+	go fetch("a", &aPrio, aDone)
+	waitPending(s, "a", Readahead)
+	relA, _ := s.acquire(ctx, "a", fixed(Interactive), 0)
+	defer relA()
+	bDone := make(chan struct{})
+	go fetch("b", &bPrio, bDone)
+	waitPending(s, "b", Readahead)
+
+	// The next reads want the ranges that are being fetched as readahead. Nobody else will fetch those, so the
+	// fetchers must be upgraded rather than keep waiting for each other.
+	aPrio.Store(int64(Interactive))
+	relA2, _ := s.acquire(ctx, "a", fixed(Interactive), 0)
+	defer relA2()
+	bPrio.Store(int64(Interactive))
+	relB2, _ := s.acquire(ctx, "b", fixed(Interactive), 0)
+	defer relB2()
+	for _, done := range []chan struct{}{aDone, bDone} {
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("fetchers deadlocked waiting for each other's reads")
+		}
+	}
+}
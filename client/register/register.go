@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
 	"path/filepath"
 	"time"
 
 	"github.com/sgielen/rufs/client/config"
+	"github.com/sgielen/rufs/client/proxy"
 	pb "github.com/sgielen/rufs/proto"
 	"github.com/sgielen/rufs/security"
 	"github.com/sgielen/rufs/version"
@@ -16,13 +18,22 @@ import (
 	"google.golang.org/grpc/credentials"
 )
 
-func Register(ctx context.Context, circle, username, token, caFingerprint string) error {
+// Register registers with the discovery server of the given circle. The device is optional and
+// allows the same user to be connected from multiple devices at the same time.
+func Register(ctx context.Context, circle, username, device, token, caFingerprint string) error {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	tc, caPromise := security.TLSConfigForRegistration(circle, caFingerprint)
 
-	conn, err := grpc.DialContext(ctx, circle, grpc.WithTransportCredentials(credentials.NewTLS(tc)), grpc.WithReturnConnectionError(), grpc.FailOnNonTempDialError(true))
+	// We don't have a configuration for this circle yet, so we use the global proxy.
+	dial, err := proxy.Dialer(proxy.Resolve(""))
+	if err != nil {
+		return err
+	}
+	conn, err := grpc.DialContext(ctx, circle, grpc.WithTransportCredentials(credentials.NewTLS(tc)), grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+		return dial(ctx, "tcp", addr)
+	}), grpc.WithReturnConnectionError(), grpc.FailOnNonTempDialError(true))
 	if err != nil {
 		return fmt.Errorf("failed to connect to discovery server: %v", err)
 	}
@@ -43,6 +54,7 @@ func Register(ctx context.Context, circle, username, token, caFingerprint string
 		Token:         token,
 		PublicKey:     pub,
 		ClientVersion: version.GetVersion(),
+		Device:        device,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to register with circle: %v", err)
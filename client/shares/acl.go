@@ -0,0 +1,90 @@
+package shares
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/ory/go-convenience/stringslice"
+	"github.com/sgielen/rufs/client/config"
+	"github.com/sgielen/rufs/common"
+	pb "github.com/sgielen/rufs/proto"
+)
+
+var (
+	groupsMtx sync.Mutex
+	// discoveryGroups are the groups the discovery server of each circle sent us.
+	discoveryGroups = map[string]map[string][]string{}
+	groupsListeners []func(circle string)
+)
+
+func handleGroups(ctx context.Context, req *pb.ConnectResponse_GroupList, circle string) {
+	groups := map[string][]string{}
+	for _, g := range req.GetGroups() {
+		groups[g.GetName()] = g.GetMembers()
+	}
+	groupsMtx.Lock()
+	discoveryGroups[circle] = groups
+	listeners := groupsListeners
+	groupsMtx.Unlock()
+	for _, l := range listeners {
+		l(circle)
+	}
+}
+
+// RegisterGroupsListener registers a callback that is called when the groups of a circle changed.
+func RegisterGroupsListener(callback func(circle string)) {
+	groupsMtx.Lock()
+	defer groupsMtx.Unlock()
+	groupsListeners = append(groupsListeners, callback)
+}
+
+// groupMembers returns the members of a group. Groups from config.yaml take precedence over those from the discovery
+// server.
+func groupMembers(cfg config.Circle, group string) []string {
+	if members, ok := cfg.Groups[group]; ok {
+		return members
+	}
+	groupsMtx.Lock()
+	defer groupsMtx.Unlock()
+	return discoveryGroups[cfg.Name][group]
+}
+
+// isListed returns whether peer is in users or in one of groups. Both can list either a specific device or a user,
+// which includes all their devices.
+func isListed(cfg config.Circle, peer string, users, groups []string) bool {
+	names := []string{peer, common.PeerWithoutDevice(peer)}
+	for _, n := range names {
+		if stringslice.Has(users, n) {
+			return true
+		}
+	}
+	for _, g := range groups {
+		members := groupMembers(cfg, g)
+		for _, n := range names {
+			if stringslice.Has(members, n) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MayRead returns whether peer may read remotePath. Everyone may read shares without Readers and ReaderGroups.
+func MayRead(circle, peer, remotePath string) bool {
+	cfg, ok := config.GetCircle(circle)
+	if !ok {
+		return false
+	}
+	name := strings.Split(remotePath, "/")[0]
+	for _, s := range cfg.Shares {
+		if s.Remote != name {
+			continue
+		}
+		if len(s.Readers) == 0 && len(s.ReaderGroups) == 0 {
+			return true
+		}
+		return isListed(cfg, peer, s.Readers, s.ReaderGroups) || isListed(cfg, peer, s.Writers, s.WriterGroups)
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+package shares
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sgielen/rufs/client/config"
+	pb "github.com/sgielen/rufs/proto"
+)
+
+func TestMayRead(t *testing.T) {
+	dir := t.TempDir()
+	cfg := `circles:
+- name: example.com
+  groups:
+    team: [erin@example.com, frank+laptop@example.com]
+  shares:
+  - remote: public
+    local: %[1]s/public
+  - remote: family
+    local: %[1]s/family
+    readers: [alice@example.com, bob+phone@example.com]
+  - remote: team
+    local: %[1]s/team
+    reader_groups: [team]
+  - remote: ops
+    local: %[1]s/ops
+    reader_groups: [ops]
+  - remote: unknown
+    local: %[1]s/unknown
+    reader_groups: [nosuchgroup]
+  - remote: project
+    local: %[1]s/project
+    readers: [carol@example.com]
+    writers: [dave@example.com]
+    writer_groups: [team]
+`
+	for _, s := range []string{"public", "family", "team", "ops", "unknown", "project"} {
+		if err := os.Mkdir(filepath.Join(dir, s), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fn := filepath.Join(dir, "config.yaml")
+	if err := os.WriteFile(fn, []byte(fmt.Sprintf(cfg, filepath.ToSlash(dir))), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := flag.Set("config", fn); err != nil {
+		t.Fatal(err)
+	}
+	if err := config.LoadConfig(); err != nil {
+		t.Fatalf("LoadConfig() failed: %v", err)
+	}
+	circles = map[string]*circle{}
+	if err := ReloadConfig(); err != nil {
+		t.Fatalf("ReloadConfig() failed: %v", err)
+	}
+	handleGroups(context.Background(), &pb.ConnectResponse_GroupList{
+		Groups: []*pb.Group{
+			{Name: "ops", Members: []string{"grace@example.com"}},
+			// Groups from config.yaml take precedence.
+			{Name: "team", Members: []string{"mallory@example.com"}},
+		},
+	}, "example.com")
+
+	for _, tc := range []struct {
+		peer string
+		want []string
+	}{
+		{"alice@example.com", []string{"family", "public"}},
+		{"alice+laptop@example.com", []string{"family", "public"}},
+		{"bob+phone@example.com", []string{"family", "public"}},
+		{"bob+laptop@example.com", []string{"public"}},
+		{"erin+phone@example.com", []string{"project", "public", "team"}},
+		{"frank+laptop@example.com", []string{"project", "public", "team"}},
+		{"frank+phone@example.com", []string{"public"}},
+		{"grace@example.com", []string{"ops", "public"}},
+		{"mallory@example.com", []string{"public"}},
+		{"carol@example.com", []string{"project", "public"}},
+		{"dave+desktop@example.com", []string{"project", "public"}},
+	} {
+		var got []string
+		for _, s := range []string{"public", "family", "team", "ops", "unknown", "project"} {
+			if MayRead("example.com", tc.peer, s+"/some/file") {
+				got = append(got, s)
+			}
+		}
+		sort.Strings(got)
+		if diff := cmp.Diff(tc.want, got); diff != "" {
+			t.Errorf("Shares %s may read differ (-want +got):\n%s", tc.peer, diff)
+		}
+
+		files, err := Readdir("example.com", tc.peer, "")
+		if err != nil {
+			t.Fatalf("Readdir(%q) failed: %v", tc.peer, err)
+		}
+		var listed []string
+		for _, f := range files {
+			listed = append(listed, f.GetFilename())
+		}
+		sort.Strings(listed)
+		if diff := cmp.Diff(tc.want, listed); diff != "" {
+			t.Errorf("Readdir() for %s differs (-want +got):\n%s", tc.peer, diff)
+		}
+	}
+
+	if MayRead("other.com", "alice@example.com", "public") {
+		t.Errorf("MayRead() in an unknown circle succeeded")
+	}
+	if MayRead("example.com", "alice@example.com", "nosuchshare/file") {
+		t.Errorf("MayRead() of an unknown share succeeded")
+	}
+}
@@ -0,0 +1,17 @@
+// +build darwin
+
+package shares
+
+import (
+	"os"
+	"syscall"
+	"time"
+)
+
+func birthTime(localPath string, fi os.FileInfo) time.Time {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return time.Time{}
+	}
+	return time.Unix(st.Birthtimespec.Unix())
+}
@@ -0,0 +1,26 @@
+// +build linux
+
+package shares
+
+import (
+	"os"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+func birthTime(localPath string, fi os.FileInfo) time.Time {
+	var stx unix.Statx_t
+	flags := 0
+	if fi.Mode()&os.ModeSymlink != 0 {
+		flags = unix.AT_SYMLINK_NOFOLLOW
+	}
+	if err := unix.Statx(unix.AT_FDCWD, localPath, flags, unix.STATX_BTIME, &stx); err != nil {
+		return time.Time{}
+	}
+	if stx.Mask&unix.STATX_BTIME == 0 {
+		// Not supported by this filesystem.
+		return time.Time{}
+	}
+	return time.Unix(stx.Btime.Sec, int64(stx.Btime.Nsec))
+}
@@ -0,0 +1,12 @@
+// +build !linux,!darwin,!windows
+
+package shares
+
+import (
+	"os"
+	"time"
+)
+
+func birthTime(localPath string, fi os.FileInfo) time.Time {
+	return time.Time{}
+}
@@ -0,0 +1,17 @@
+// +build windows
+
+package shares
+
+import (
+	"os"
+	"syscall"
+	"time"
+)
+
+func birthTime(localPath string, fi os.FileInfo) time.Time {
+	d, ok := fi.Sys().(*syscall.Win32FileAttributeData)
+	if !ok {
+		return time.Time{}
+	}
+	return time.Unix(0, d.CreationTime.Nanoseconds())
+}
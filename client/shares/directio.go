@@ -1,9 +1,11 @@
 package shares
 
 import (
+	"os"
+	"path"
+
 	"github.com/go-git/go-billy/v5"
 	"github.com/go-git/go-billy/v5/osfs"
-	"github.com/ory/go-convenience/stringslice"
 	"github.com/sgielen/rufs/client/config"
 	"github.com/sgielen/rufs/common"
 )
@@ -16,8 +18,8 @@ func SharesForPeer(name string) map[string]billy.Filesystem {
 	}
 	ret := map[string]billy.Filesystem{}
 	for _, s := range c.Shares {
-		if stringslice.Has(s.Writers, name) {
-			ret[s.Remote] = osfs.New(s.Local)
+		if isListed(c, name, s.Writers, s.WriterGroups) {
+			ret[s.Remote] = ignoringFS{osfs.New(s.Local), shareIgnorer(cn, s.Remote), ""}
 		}
 	}
 	return ret
@@ -26,10 +28,130 @@ func SharesForPeer(name string) map[string]billy.Filesystem {
 func HasAnyDirectIOShares() bool {
 	for _, c := range config.GetCircles() {
 		for _, s := range c.Shares {
-			if len(s.Writers) > 0 {
+			if len(s.Writers) > 0 || len(s.WriterGroups) > 0 {
 				return true
 			}
 		}
 	}
 	return false
 }
+
+// ignoringFS hides excluded files from directio peers. They don't exist for reading and can't be created.
+type ignoringFS struct {
+	billy.Filesystem
+	ignorer *ignorer
+	// prefix is the path of the root of this filesystem in the share, after Chroot().
+	prefix string
+}
+
+func (fs ignoringFS) check(op, filename string, create bool) error {
+	if !fs.ignorer.matcher().ignored(path.Join(fs.prefix, filename)) {
+		return nil
+	}
+	if create {
+		return &os.PathError{Op: op, Path: filename, Err: os.ErrPermission}
+	}
+	return &os.PathError{Op: op, Path: filename, Err: os.ErrNotExist}
+}
+
+func (fs ignoringFS) Create(filename string) (billy.File, error) {
+	if err := fs.check("create", filename, true); err != nil {
+		return nil, err
+	}
+	return fs.Filesystem.Create(filename)
+}
+
+func (fs ignoringFS) Open(filename string) (billy.File, error) {
+	if err := fs.check("open", filename, false); err != nil {
+		return nil, err
+	}
+	return fs.Filesystem.Open(filename)
+}
+
+func (fs ignoringFS) OpenFile(filename string, flag int, perm os.FileMode) (billy.File, error) {
+	if err := fs.check("open", filename, flag&os.O_CREATE != 0); err != nil {
+		return nil, err
+	}
+	return fs.Filesystem.OpenFile(filename, flag, perm)
+}
+
+func (fs ignoringFS) Stat(filename string) (os.FileInfo, error) {
+	if err := fs.check("stat", filename, false); err != nil {
+		return nil, err
+	}
+	return fs.Filesystem.Stat(filename)
+}
+
+func (fs ignoringFS) Lstat(filename string) (os.FileInfo, error) {
+	if err := fs.check("lstat", filename, false); err != nil {
+		return nil, err
+	}
+	return fs.Filesystem.Lstat(filename)
+}
+
+func (fs ignoringFS) Rename(oldpath, newpath string) error {
+	if err := fs.check("rename", oldpath, false); err != nil {
+		return err
+	}
+	if err := fs.check("rename", newpath, true); err != nil {
+		return err
+	}
+	return fs.Filesystem.Rename(oldpath, newpath)
+}
+
+func (fs ignoringFS) Remove(filename string) error {
+	if err := fs.check("remove", filename, false); err != nil {
+		return err
+	}
+	return fs.Filesystem.Remove(filename)
+}
+
+func (fs ignoringFS) ReadDir(dirname string) ([]os.FileInfo, error) {
+	if err := fs.check("readdir", dirname, false); err != nil {
+		return nil, err
+	}
+	entries, err := fs.Filesystem.ReadDir(dirname)
+	if err != nil {
+		return nil, err
+	}
+	m := fs.ignorer.matcher()
+	ret := entries[:0]
+	for _, e := range entries {
+		if !m.ignored(path.Join(fs.prefix, dirname, e.Name())) {
+			ret = append(ret, e)
+		}
+	}
+	return ret, nil
+}
+
+func (fs ignoringFS) MkdirAll(filename string, perm os.FileMode) error {
+	if err := fs.check("mkdir", filename, true); err != nil {
+		return err
+	}
+	return fs.Filesystem.MkdirAll(filename, perm)
+}
+
+func (fs ignoringFS) Symlink(target, link string) error {
+	if err := fs.check("symlink", link, true); err != nil {
+		return err
+	}
+	return fs.Filesystem.Symlink(target, link)
+}
+
+func (fs ignoringFS) Readlink(link string) (string, error) {
+	if err := fs.check("readlink", link, false); err != nil {
+		return "", err
+	}
+	return fs.Filesystem.Readlink(link)
+}
+
+func (fs ignoringFS) Chroot(p string) (billy.Filesystem, error) {
+	if err := fs.check("chroot", p, false); err != nil {
+		return nil, err
+	}
+	sub, err := fs.Filesystem.Chroot(p)
+	if err != nil {
+		return nil, err
+	}
+	return ignoringFS{sub, fs.ignorer, path.Join(fs.prefix, p)}, nil
+}
@@ -0,0 +1,155 @@
+package shares
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ignoreFile lists patterns to exclude, relative to the root of the share it's in.
+const ignoreFile = ".rufsignore"
+
+// ignorer decides which files of a share are excluded. It combines the patterns from the config with those from the
+// share's .rufsignore, which is reread when it changes.
+type ignorer struct {
+	root         string
+	patterns     []string
+	hideDotfiles bool
+
+	mtx          sync.Mutex
+	fileMtime    time.Time
+	fileSize     int64
+	filePatterns []string
+}
+
+func newIgnorer(root string, patterns []string, hideDotfiles bool) *ignorer {
+	return &ignorer{
+		root:         root,
+		patterns:     patterns,
+		hideDotfiles: hideDotfiles,
+		fileSize:     -1,
+	}
+}
+
+// ignoreMatcher is a snapshot of the rules of an ignorer.
+type ignoreMatcher struct {
+	patterns     []string
+	hideDotfiles bool
+}
+
+// matcher returns the current rules. It's safe to call on a nil ignorer, which excludes nothing but .rufsignore.
+func (ig *ignorer) matcher() ignoreMatcher {
+	if ig == nil {
+		return ignoreMatcher{}
+	}
+	ig.mtx.Lock()
+	defer ig.mtx.Unlock()
+	st, err := os.Stat(filepath.Join(ig.root, ignoreFile))
+	if err != nil {
+		ig.filePatterns = nil
+		ig.fileSize = -1
+	} else if st.Size() != ig.fileSize || !st.ModTime().Equal(ig.fileMtime) {
+		data, err := os.ReadFile(filepath.Join(ig.root, ignoreFile))
+		if err != nil {
+			log.Printf("Failed to read %s: %v", filepath.Join(ig.root, ignoreFile), err)
+		}
+		ig.filePatterns = parseIgnoreFile(data)
+		ig.fileMtime = st.ModTime()
+		ig.fileSize = st.Size()
+	}
+	return ignoreMatcher{
+		patterns:     append(append([]string{}, ig.patterns...), ig.filePatterns...),
+		hideDotfiles: ig.hideDotfiles,
+	}
+}
+
+// checkPatterns returns an error if any of the patterns is malformed.
+func checkPatterns(patterns []string) error {
+	for _, p := range patterns {
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("exclude pattern %q: %v", p, err)
+		}
+	}
+	return nil
+}
+
+// parseIgnoreFile returns the patterns in a .rufsignore: one per line, ignoring empty lines and lines starting with #.
+func parseIgnoreFile(data []byte) []string {
+	var ret []string
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for s.Scan() {
+		l := strings.TrimSpace(s.Text())
+		if l == "" || strings.HasPrefix(l, "#") {
+			continue
+		}
+		ret = append(ret, l)
+	}
+	return ret
+}
+
+// ignored returns whether rel, a slash-separated path relative to the share root, is excluded. Excluding a directory
+// excludes everything in it. Patterns without a slash match a file or directory with that name anywhere in the share;
+// patterns with a slash match relative to the share root.
+func (m ignoreMatcher) ignored(rel string) bool {
+	rel = strings.Trim(path.Clean("/"+rel), "/")
+	if rel == "" {
+		return false
+	}
+	components := strings.Split(rel, "/")
+	if components[0] == ignoreFile {
+		return true
+	}
+	for _, c := range components {
+		if m.hideDotfiles && strings.HasPrefix(c, ".") {
+			return true
+		}
+	}
+	for _, p := range m.patterns {
+		p = strings.TrimSuffix(p, "/")
+		if strings.Contains(p, "/") {
+			pc := strings.Split(strings.TrimPrefix(p, "/"), "/")
+			if len(pc) > len(components) {
+				continue
+			}
+			if matched, _ := path.Match(strings.Join(pc, "/"), strings.Join(components[:len(pc)], "/")); matched {
+				return true
+			}
+			continue
+		}
+		for _, c := range components {
+			if matched, _ := path.Match(p, c); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ignoredTarget returns whether real, the resolved local path of a file in the share at shareRoot, is excluded. Paths
+// outside the share aren't excluded by it.
+func (m ignoreMatcher) ignoredTarget(shareRoot, real string) bool {
+	if !withinRoot(shareRoot, real) {
+		return false
+	}
+	rel, err := filepath.Rel(shareRoot, real)
+	if err != nil {
+		return true
+	}
+	return m.ignored(filepath.ToSlash(rel))
+}
+
+// shareIgnorer returns the ignorer of a share, or nil if there is no such share.
+func shareIgnorer(circle, share string) *ignorer {
+	c, ok := circles[circle]
+	if !ok {
+		return nil
+	}
+	return c.ignorers[share]
+}
@@ -0,0 +1,84 @@
+package shares
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestIgnored(t *testing.T) {
+	root := t.TempDir()
+	ig := newIgnorer(root, []string{".DS_Store", "*.part", "build/", "/docs/*.tmp"}, false)
+	for _, tc := range []struct {
+		rel  string
+		want bool
+	}{
+		{"", false},
+		{"photo.jpg", false},
+		{".DS_Store", true},
+		{"a/b/.DS_Store", true},
+		{"movie.mkv.part", true},
+		{"build", true},
+		{"src/build/out.o", true},
+		{"docs/x.tmp", true},
+		{"docs/sub/x.tmp", false},
+		{"src/docs/x.tmp", false},
+		{".rufsignore", true},
+		{"sub/.rufsignore", false},
+		{".git/config", false},
+	} {
+		if got := ig.matcher().ignored(tc.rel); got != tc.want {
+			t.Errorf("ignored(%q) = %v; want %v", tc.rel, got, tc.want)
+		}
+	}
+
+	// .rufsignore is picked up when it changes.
+	if err := os.WriteFile(filepath.Join(root, ignoreFile), []byte("# Version control\n.git\n\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if !ig.matcher().ignored(".git/config") {
+		t.Errorf("ignored(.git/config) = false after adding it to .rufsignore; want true")
+	}
+	if err := os.Remove(filepath.Join(root, ignoreFile)); err != nil {
+		t.Fatal(err)
+	}
+	if ig.matcher().ignored(".git/config") {
+		t.Errorf("ignored(.git/config) = true after removing .rufsignore; want false")
+	}
+
+	hide := newIgnorer(root, nil, true)
+	if !hide.matcher().ignored("a/.hidden/b") || hide.matcher().ignored("a/visible") {
+		t.Errorf("hide_dotfiles doesn't hide exactly the dotfiles")
+	}
+}
+
+func TestIgnoredLinkTargets(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "secret.txt"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("secret.txt", filepath.Join(root, "alias")); err != nil {
+		t.Skipf("Can't create symlinks: %v", err)
+	}
+	defer func(old map[string]*circle) {
+		circles = old
+	}(circles)
+	circles = map[string]*circle{
+		"example.com": {
+			shares:   map[string]string{"share": root},
+			ignorers: map[string]*ignorer{"share": newIgnorer(root, []string{"secret.txt"}, false)},
+			symlinks: map[string]symlinkPolicy{"share": symlinksWithinShare},
+		},
+	}
+
+	if _, err := resolveRemotePath("example.com", "share/alias"); err == nil {
+		t.Errorf("resolveRemotePath() of a link to an excluded file succeeded")
+	}
+	files, err := Readdir("example.com", "", "share")
+	if err != nil {
+		t.Fatalf("Readdir() failed: %v", err)
+	}
+	if len(files) != 0 {
+		t.Errorf("Readdir() listed %d files; want none", len(files))
+	}
+}
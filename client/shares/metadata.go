@@ -0,0 +1,53 @@
+package shares
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	pb "github.com/sgielen/rufs/proto"
+)
+
+// maxXattrSize is how many bytes of extended attributes we send per file. Attributes beyond it are left out.
+const maxXattrSize = 64 * 1024
+
+// maxReaddirXattrSize is how many bytes of extended attributes we send per directory listing, so that it stays well
+// below gRPC's default message size limit of 4MB. Files beyond it are listed without their attributes.
+const maxReaddirXattrSize = 1024 * 1024
+
+// skippedXattrs are attributes we never send, because they can be huge and are of no use on other systems.
+var skippedXattrs = []string{"com.apple.ResourceFork"}
+
+// windowsExecutables are the extensions of files we call executable when we're on Windows, which has no permission bits.
+var windowsExecutables = []string{".exe", ".bat", ".cmd", ".com", ".ps1"}
+
+// addMetadata fills in the metadata of file from fi, which describes localPath. At most maxXattrs bytes of extended
+// attributes are added. It returns how many were.
+func addMetadata(file *pb.File, localPath string, fi os.FileInfo, maxXattrs int) int {
+	file.MtimeNanos = int32(fi.ModTime().Nanosecond())
+	if bt := birthTime(localPath, fi); !bt.IsZero() {
+		file.Birthtime = bt.Unix()
+		file.BirthtimeNanos = int32(bt.Nanosecond())
+	}
+	if runtime.GOOS == "windows" {
+		ext := strings.ToLower(filepath.Ext(localPath))
+		for _, e := range windowsExecutables {
+			if ext == e {
+				file.Executable = true
+			}
+		}
+	} else {
+		file.Mode = uint32(fi.Mode().Perm())
+		file.Executable = !fi.IsDir() && fi.Mode().Perm()&0111 != 0
+	}
+	if maxXattrs <= 0 || fi.Mode()&os.ModeSymlink != 0 {
+		return 0
+	}
+	if maxXattrs > maxXattrSize {
+		maxXattrs = maxXattrSize
+	}
+	var size int
+	file.Xattrs, size = readXattrs(localPath, maxXattrs)
+	return size
+}
@@ -23,27 +23,44 @@ var (
 )
 
 type circle struct {
-	shares map[string]string
+	shares   map[string]string
+	ignorers map[string]*ignorer
+	symlinks map[string]symlinkPolicy
+	xattrs   map[string]bool
 }
 
 func Init() error {
 	circles = map[string]*circle{}
 	go hashWorker()
 	connectivity.HandleResolveConflictRequest = handleResolveConflictRequest
+	connectivity.HandleGroups = handleGroups
 	return ReloadConfig()
 }
 
 func ReloadConfig() error {
 	for _, cfg := range config.GetCircles() {
 		c := &circle{
-			shares: map[string]string{},
+			shares:   map[string]string{},
+			ignorers: map[string]*ignorer{},
+			symlinks: map[string]symlinkPolicy{},
+			xattrs:   map[string]bool{},
 		}
 		for _, s := range cfg.Shares {
 			local, err := resolveSharePath(s)
 			if err != nil {
 				return fmt.Errorf("invalid share %q: %v", s.Remote, err)
 			}
+			if err := checkPatterns(s.Exclude); err != nil {
+				return fmt.Errorf("invalid share %q: %v", s.Remote, err)
+			}
+			policy, err := parseSymlinkPolicy(s.Symlinks)
+			if err != nil {
+				return fmt.Errorf("invalid share %q: %v", s.Remote, err)
+			}
 			c.shares[s.Remote] = local
+			c.ignorers[s.Remote] = newIgnorer(local, s.Exclude, s.HideDotfiles)
+			c.symlinks[s.Remote] = policy
+			c.xattrs[s.Remote] = s.Xattrs
 		}
 		circles[cfg.Name] = c
 	}
@@ -95,21 +112,41 @@ func resolveRemotePath(circle, remotePath string) (string, error) {
 	if !ok {
 		return "", status.Errorf(codes.NotFound, "share %s not found", remote)
 	}
+	m := c.ignorers[remote].matcher()
+	if m.ignored(strings.Join(sp[1:], "/")) {
+		return "", status.Errorf(codes.NotFound, "file %q not found", remotePath)
+	}
 	if strings.Contains(remainder, "../") {
 		return "", status.Error(codes.InvalidArgument, "illegal path containing ../ refused")
 	}
 	localPath := filepath.Join(shareRoot, remainder)
+	switch c.symlinks[remote] {
+	case symlinksExpose, symlinksHide:
+		// We never follow links, so peers can't open paths through them.
+		if err := checkNoSymlinks(shareRoot, remainder); err != nil {
+			return "", makeRemoteError(remotePath, err)
+		}
+		return localPath, nil
+	}
 	realLocalPath, err := realpath.Realpath(localPath)
 	if err != nil {
 		return "", makeRemoteError(remotePath, err)
 	}
-	if !strings.HasPrefix(strings.TrimSuffix(realLocalPath, "/"), shareRoot) {
+	if c.symlinks[remote] != symlinksFollow && !withinRoot(shareRoot, realLocalPath) {
 		return "", status.Errorf(codes.InvalidArgument, "share %s not found", remote)
 	}
+	// Links can't be used to read excluded files.
+	if m.ignoredTarget(shareRoot, realLocalPath) {
+		return "", status.Errorf(codes.NotFound, "file %q not found", remotePath)
+	}
 	return localPath, nil
 }
 
-func Open(circle, remotePath string) (*os.File, error) {
+// Open opens remotePath for peer. peer is empty if we're opening the file for ourselves.
+func Open(circle, peer, remotePath string) (*os.File, error) {
+	if peer != "" && !MayRead(circle, peer, remotePath) {
+		return nil, status.Errorf(codes.NotFound, "share %s not found", strings.Split(remotePath, "/")[0])
+	}
 	localPath, err := resolveRemotePath(circle, remotePath)
 	if err != nil {
 		return nil, err
@@ -133,10 +170,14 @@ func Stat(circle, remotePath string) (os.FileInfo, error) {
 	return info, nil
 }
 
-func Readdir(circle, remotePath string) ([]*pb.File, error) {
+// Readdir lists remotePath for peer. Shares peer may not read aren't listed.
+func Readdir(circle, peer, remotePath string) ([]*pb.File, error) {
 	var ret []*pb.File
 	if remotePath == "" {
 		for remote := range circles[circle].shares {
+			if !MayRead(circle, peer, remote) {
+				continue
+			}
 			ret = append(ret, &pb.File{
 				Filename:    remote,
 				IsDirectory: true,
@@ -145,7 +186,7 @@ func Readdir(circle, remotePath string) ([]*pb.File, error) {
 		}
 		return ret, nil
 	}
-	dh, err := Open(circle, remotePath)
+	dh, err := Open(circle, peer, remotePath)
 	if err != nil {
 		return nil, err
 	}
@@ -154,14 +195,46 @@ func Readdir(circle, remotePath string) ([]*pb.File, error) {
 	if err != nil {
 		return nil, err
 	}
+	sp := strings.SplitN(remotePath, "/", 2)
+	dir := ""
+	if len(sp) > 1 {
+		dir = sp[1]
+	}
+	m := shareIgnorer(circle, sp[0]).matcher()
+	policy := circles[circle].symlinks[sp[0]]
+	var xattrBudget int
+	if circles[circle].xattrs[sp[0]] {
+		xattrBudget = maxReaddirXattrSize
+	}
 	for _, dirfile := range entries {
+		if m.ignored(dir + "/" + dirfile.Name()) {
+			continue
+		}
+		var target string
+		if dirfile.Mode()&os.ModeSymlink != 0 {
+			var ok bool
+			linkPath := filepath.Join(dh.Name(), dirfile.Name())
+			dirfile, target, ok = symlinkEntry(policy, circles[circle].shares[sp[0]], linkPath, dirfile)
+			if !ok {
+				continue
+			}
+			if target == "" {
+				// Don't list links to excluded files, as they can't be opened.
+				if real, err := realpath.Realpath(linkPath); err != nil || m.ignoredTarget(circles[circle].shares[sp[0]], real) {
+					continue
+				}
+			}
+		}
+		localPath := filepath.Join(dh.Name(), dirfile.Name())
 		file := &pb.File{
-			Filename:    dirfile.Name(),
-			IsDirectory: dirfile.IsDir(),
-			Size:        dirfile.Size(),
-			Mtime:       dirfile.ModTime().Unix(),
+			Filename:      dirfile.Name(),
+			IsDirectory:   dirfile.IsDir(),
+			Size:          dirfile.Size(),
+			Mtime:         dirfile.ModTime().Unix(),
+			SymlinkTarget: target,
 		}
-		if h := getFileHash(filepath.Join(dh.Name(), dirfile.Name()), dirfile); h != "" {
+		xattrBudget -= addMetadata(file, localPath, dirfile, xattrBudget)
+		if h := getFileHash(localPath, dirfile); h != "" {
 			file.Hash = h
 		}
 		ret = append(ret, file)
@@ -0,0 +1,103 @@
+package shares
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/yookoala/realpath"
+)
+
+// symlinkPolicy is how a share treats symbolic links.
+type symlinkPolicy string
+
+const (
+	// symlinksWithinShare follows links that point inside the share and hides the others.
+	symlinksWithinShare symlinkPolicy = "follow_within_share"
+	// symlinksFollow follows all links.
+	symlinksFollow symlinkPolicy = "follow"
+	// symlinksExpose shows links as links, with their target.
+	symlinksExpose symlinkPolicy = "expose"
+	// symlinksHide hides all links.
+	symlinksHide symlinkPolicy = "hide"
+)
+
+func parseSymlinkPolicy(s string) (symlinkPolicy, error) {
+	switch p := symlinkPolicy(s); p {
+	case "":
+		return symlinksWithinShare, nil
+	case symlinksWithinShare, symlinksFollow, symlinksExpose, symlinksHide:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unknown symlink policy %q (want %s, %s, %s or %s)", s, symlinksWithinShare, symlinksFollow, symlinksExpose, symlinksHide)
+	}
+}
+
+// withinRoot returns whether the local path p is root or inside it.
+func withinRoot(root, p string) bool {
+	root = strings.TrimSuffix(root, string(filepath.Separator))
+	return p == root || strings.HasPrefix(p, root+string(filepath.Separator))
+}
+
+// checkNoSymlinks returns an error if any component of remainder (a local path relative to root) is a symbolic link.
+func checkNoSymlinks(root, remainder string) error {
+	p := root
+	for _, c := range strings.Split(remainder, string(filepath.Separator)) {
+		if c == "" {
+			continue
+		}
+		p = filepath.Join(p, c)
+		st, err := os.Lstat(p)
+		if err != nil {
+			return err
+		}
+		if st.Mode()&os.ModeSymlink != 0 {
+			return os.ErrNotExist
+		}
+	}
+	return nil
+}
+
+// symlinkEntry applies a symlink policy to a link found by Readdir. It returns the info to list and, if the link is
+// exposed, its target. It returns false if the link should be hidden.
+func symlinkEntry(policy symlinkPolicy, shareRoot, localPath string, lst os.FileInfo) (os.FileInfo, string, bool) {
+	switch policy {
+	case symlinksHide:
+		return nil, "", false
+	case symlinksExpose:
+		target, err := os.Readlink(localPath)
+		if err != nil {
+			return nil, "", false
+		}
+		resolved := target
+		if !filepath.IsAbs(resolved) {
+			resolved = filepath.Join(filepath.Dir(localPath), resolved)
+		}
+		if !withinRoot(shareRoot, filepath.Clean(resolved)) {
+			// The target would leak our local paths, and would point into the peer's own filesystem.
+			return nil, "", false
+		}
+		if filepath.IsAbs(target) {
+			// Make it work for peers, who have the share in a different place.
+			rel, err := filepath.Rel(filepath.Dir(localPath), target)
+			if err != nil {
+				return nil, "", false
+			}
+			target = rel
+		}
+		return lst, filepath.ToSlash(target), true
+	}
+	st, err := os.Stat(localPath)
+	if err != nil {
+		// Dangling link.
+		return nil, "", false
+	}
+	if policy == symlinksWithinShare {
+		real, err := realpath.Realpath(localPath)
+		if err != nil || !withinRoot(shareRoot, real) {
+			return nil, "", false
+		}
+	}
+	return st, "", true
+}
@@ -0,0 +1,39 @@
+package shares
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSymlinkEntryExpose(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "share")
+	if err := os.MkdirAll(filepath.Join(root, "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		name   string
+		target string
+		want   string
+		ok     bool
+	}{
+		{"relative", "../a.txt", "../a.txt", true},
+		{"absolute-inside", filepath.Join(root, "a.txt"), "../a.txt", true},
+		{"absolute-outside", "/etc/passwd", "", false},
+		{"relative-outside", "../../outside", "", false},
+		{"sibling-share", filepath.Join(filepath.Dir(root), "other"), "", false},
+	} {
+		lp := filepath.Join(root, "sub", tc.name)
+		if err := os.Symlink(tc.target, lp); err != nil {
+			t.Skipf("Can't create symlinks: %v", err)
+		}
+		lst, err := os.Lstat(lp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, got, ok := symlinkEntry(symlinksExpose, root, lp, lst)
+		if ok != tc.ok || got != tc.want {
+			t.Errorf("symlinkEntry(%q -> %q) = %q, %v; want %q, %v", tc.name, tc.target, got, ok, tc.want, tc.ok)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package shares
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestReadXattrs(t *testing.T) {
+	fn := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(fn, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"user.a", "user.b"} {
+		if err := unix.Setxattr(fn, name, []byte("0123456789"), 0); err != nil {
+			t.Skipf("Setxattr() failed, xattrs are probably not supported here: %v", err)
+		}
+	}
+
+	if got, size := readXattrs(fn, maxXattrSize); len(got) != 2 || size != 32 {
+		t.Errorf("readXattrs() = %q, %d; want 2 attributes of 32 bytes", got, size)
+	}
+	// Attributes that don't fit are left out.
+	if got, size := readXattrs(fn, 20); len(got) != 1 || size != 16 {
+		t.Errorf("readXattrs() with a budget of 20 bytes = %q, %d; want 1 attribute of 16 bytes", got, size)
+	}
+}
@@ -0,0 +1,44 @@
+// +build linux darwin
+
+package shares
+
+import (
+	"runtime"
+	"strings"
+
+	"github.com/ory/go-convenience/stringslice"
+	"golang.org/x/sys/unix"
+)
+
+// readXattrs returns at most max bytes of extended attributes of localPath, and their size. On Linux, only the user
+// namespace is read.
+func readXattrs(localPath string, max int) (map[string][]byte, int) {
+	sz, err := unix.Listxattr(localPath, nil)
+	if err != nil || sz == 0 {
+		return nil, 0
+	}
+	buf := make([]byte, sz)
+	sz, err = unix.Listxattr(localPath, buf)
+	if err != nil {
+		return nil, 0
+	}
+	ret := map[string][]byte{}
+	total := 0
+	for _, name := range strings.Split(strings.TrimRight(string(buf[:sz]), "\x00"), "\x00") {
+		if name == "" || (runtime.GOOS == "linux" && !strings.HasPrefix(name, "user.")) || stringslice.Has(skippedXattrs, name) {
+			continue
+		}
+		vsz, err := unix.Getxattr(localPath, name, nil)
+		if err != nil || total+len(name)+vsz > max {
+			continue
+		}
+		value := make([]byte, vsz)
+		vsz, err = unix.Getxattr(localPath, name, value)
+		if err != nil {
+			continue
+		}
+		ret[name] = value[:vsz]
+		total += len(name) + vsz
+	}
+	return ret, total
+}
@@ -10,8 +10,10 @@ import (
 	"sync"
 	"time"
 
+	"github.com/sgielen/rufs/client/compression"
 	"github.com/sgielen/rufs/client/connectivity"
 	"github.com/sgielen/rufs/client/metrics"
+	"github.com/sgielen/rufs/client/ratelimit"
 	"github.com/sgielen/rufs/common"
 	pb "github.com/sgielen/rufs/proto"
 	"github.com/sgielen/rufs/security"
@@ -25,8 +27,14 @@ type backend interface {
 
 type TransferClient interface {
 	ReceivedBytes(start, end int64, peer string)
+	// WaitDownload blocks until we may receive [start, end), which depends on its priority and the download limit.
+	WaitDownload(ctx context.Context, start, end int64) error
 	SetConnectedPeers(peers []string)
 	UploadFailed(peer string)
+	// Uploaded is called after we sent [start, end) to peer.
+	Uploaded(peer string, start, end int64)
+	// MayUpload returns whether we may send data of this file to peer.
+	MayUpload(peer string) bool
 	OrchestrationClosed()
 }
 
@@ -145,11 +153,12 @@ func (p *peer) connectAndHandle() error {
 		return err
 	}
 	if err := stream.Send(&pb.PassiveTransferData{
-		DownloadId: p.transfer.downloadId,
+		DownloadId:        p.transfer.downloadId,
+		AcceptCompression: compression.Accepted(),
 	}); err != nil {
 		return err
 	}
-	return p.handleStream(stream)
+	return p.handleStream(&compressingStream{PassiveStream: stream})
 }
 
 func (p *peer) handleStream(stream PassiveStream) error {
@@ -191,10 +200,15 @@ func (p *peer) handleInboundData(stream PassiveStream) error {
 		if err != nil {
 			return err
 		}
+		start, end := msg.GetOffset(), msg.GetOffset()+int64(len(msg.GetData()))
+		// Not reading from the stream stops the sender, e.g. while other transfers are waiting for more urgent data.
+		if err := p.transfer.callbacks.WaitDownload(p.transfer.ctx, start, end); err != nil {
+			return err
+		}
 		if _, err = p.transfer.storage.WriteAt(msg.GetData(), msg.GetOffset()); err != nil {
 			return err
 		}
-		p.transfer.callbacks.ReceivedBytes(msg.GetOffset(), msg.GetOffset()+int64(len(msg.GetData())), p.name)
+		p.transfer.callbacks.ReceivedBytes(start, end, p.name)
 	}
 }
 
@@ -226,6 +240,9 @@ func (p *peer) transmitDataLoop(stream PassiveStream, quit <-chan struct{}) (int
 			p.pendingTransmissions = p.pendingTransmissions[1:]
 			p.mtx.Unlock()
 			offset, internalError, err := p.upload(stream, task)
+			if offset > task.GetStart() {
+				p.transfer.callbacks.Uploaded(p.name, task.GetStart(), offset)
+			}
 			p.mtx.Lock()
 			if err != nil {
 				p.pendingTransmissions = append([]*pb.Range{{Start: offset, End: task.End}}, p.pendingTransmissions...)
@@ -256,6 +273,9 @@ func (p *peer) upload(stream PassiveStream, task *pb.Range) (remainingOffset int
 		if err != nil {
 			return offset, true, err
 		}
+		if err := ratelimit.Wait(p.transfer.ctx, p.name, n); err != nil {
+			return offset, false, err
+		}
 		if err := stream.Send(&pb.PassiveTransferData{
 			Data:   buf[:n],
 			Offset: offset,
@@ -267,7 +287,8 @@ func (p *peer) upload(stream PassiveStream, task *pb.Range) (remainingOffset int
 	}
 }
 
-func (t *Transfer) HandleIncomingPassiveTransfer(stream pb.ContentService_PassiveTransferServer) error {
+// HandleIncomingPassiveTransfer handles a stream of which the first message, hello, was already read.
+func (t *Transfer) HandleIncomingPassiveTransfer(stream pb.ContentService_PassiveTransferServer, hello *pb.PassiveTransferData) error {
 	name, _, err := security.PeerFromContext(stream.Context())
 	if err != nil {
 		return err
@@ -278,10 +299,24 @@ func (t *Transfer) HandleIncomingPassiveTransfer(stream pb.ContentService_Passiv
 		p = t.addPeer(name)
 	}
 	t.mtx.Unlock()
-	return p.handleStream(stream)
+	cs := &compressingStream{PassiveStream: stream, compression: compression.Pick(hello.GetAcceptCompression())}
+	if accepted := compression.Accepted(); len(hello.GetAcceptCompression()) > 0 && len(accepted) > 0 {
+		// Peers that don't send accept_compression might not handle messages without data.
+		if err := stream.Send(&pb.PassiveTransferData{
+			AcceptCompression: accepted,
+		}); err != nil {
+			return err
+		}
+	}
+	return p.handleStream(cs)
 }
 
 func (t *Transfer) Upload(ctx context.Context, peer string, byteRange *pb.Range) {
+	if !t.callbacks.MayUpload(peer) {
+		log.Printf("Requested upload failed: peer %s may not read this file", peer)
+		t.callbacks.UploadFailed(peer)
+		return
+	}
 	t.mtx.Lock()
 	defer t.mtx.Unlock()
 	p, ok := t.peers[peer]
@@ -314,3 +349,49 @@ type PassiveStream interface {
 	Recv() (*pb.PassiveTransferData, error)
 	Send(*pb.PassiveTransferData) error
 }
+
+// compressingStream compresses the data we send if the other side told us it can decompress it, and decompresses the
+// data we receive.
+type compressingStream struct {
+	PassiveStream
+
+	mtx         sync.Mutex
+	compression pb.Compression
+}
+
+func (s *compressingStream) Send(msg *pb.PassiveTransferData) error {
+	s.mtx.Lock()
+	c := s.compression
+	s.mtx.Unlock()
+	data, c := compression.Compress(c, msg.GetData())
+	return s.PassiveStream.Send(&pb.PassiveTransferData{
+		DownloadId:  msg.GetDownloadId(),
+		Offset:      msg.GetOffset(),
+		Data:        data,
+		Compression: c,
+	})
+}
+
+func (s *compressingStream) Recv() (*pb.PassiveTransferData, error) {
+	for {
+		msg, err := s.PassiveStream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if len(msg.GetAcceptCompression()) > 0 {
+			s.mtx.Lock()
+			s.compression = compression.Pick(msg.GetAcceptCompression())
+			s.mtx.Unlock()
+		}
+		if len(msg.GetData()) == 0 {
+			continue
+		}
+		data, err := compression.Decompress(msg.GetCompression(), msg.GetData())
+		if err != nil {
+			return nil, err
+		}
+		msg.Data = data
+		msg.Compression = pb.Compression_UNCOMPRESSED
+		return msg, nil
+	}
+}
@@ -14,8 +14,10 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/sgielen/rufs/client/compression"
 	"github.com/sgielen/rufs/client/connectivity"
 	"github.com/sgielen/rufs/client/metrics"
+	"github.com/sgielen/rufs/client/ratelimit"
 	"github.com/sgielen/rufs/client/transfer/cache"
 	"github.com/sgielen/rufs/client/transfer/orchestream"
 	"github.com/sgielen/rufs/client/transfer/passive"
@@ -30,6 +32,10 @@ var (
 
 	ForgetCallback                  func(circle string, t *Transfer)
 	RedirectToOrchestrationCallback func(circle string, t *Transfer, downloadId int64) error
+	// MayUploadCallback returns whether peer may read remoteFilename from our shares.
+	MayUploadCallback func(circle, peer, remoteFilename string) bool
+	// UploadedCallback is called after we sent [start, end) of remoteFilename from our shares to peer.
+	UploadedCallback func(circle, peer, remoteFilename string, start, end int64)
 )
 
 func NewRemoteFile(ctx context.Context, remoteFilename, maybeHash string, size int64, peers []*connectivity.Peer) (_ *Transfer, retErr error) {
@@ -139,6 +145,9 @@ type Transfer struct {
 
 type TransferHandle struct {
 	transfer *Transfer
+	// ctx is cancelled when the handle is closed, so that reads blocked on it give up.
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 func (t *Transfer) init() {
@@ -200,7 +209,8 @@ func (t *Transfer) GetHandle() *TransferHandle {
 	defer t.mtx.Unlock()
 	t.handles += 1
 	t.handlesChan <- t.handles
-	return &TransferHandle{t}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &TransferHandle{t, ctx, cancel}
 }
 
 func (h *TransferHandle) Close() error {
@@ -208,6 +218,7 @@ func (h *TransferHandle) Close() error {
 	if t == nil {
 		log.Panicf("close on a closed TransferHandle")
 	}
+	h.cancel()
 	t.mtx.Lock()
 	defer t.mtx.Unlock()
 	t.handles -= 1
@@ -216,7 +227,12 @@ func (h *TransferHandle) Close() error {
 	return nil
 }
 
-func (h *TransferHandle) ReadAt(buf []byte, offset int64) (n int, retErr error) {
+func (h *TransferHandle) ReadAt(buf []byte, offset int64) (int, error) {
+	return h.ReadAtContext(h.ctx, buf, offset)
+}
+
+// ReadAtContext is like ReadAt, but gives up waiting for data when ctx is done.
+func (h *TransferHandle) ReadAtContext(ctx context.Context, buf []byte, offset int64) (n int, retErr error) {
 	const MIN_READAHEAD_SIZE = 1024 * 32
 	t := h.transfer
 	if t == nil {
@@ -272,7 +288,24 @@ func (h *TransferHandle) ReadAt(buf []byte, offset int64) (n int, retErr error)
 		t.readahead.RemoveRange(t.want)
 		t.byteRangesUpdated()
 		t.fetchCond.Broadcast()
+		// Make other transfers' readahead wait while we're blocked, also when our data comes in passively.
+		release, err := ratelimit.AcquireDownload(ctx, t, func() ratelimit.Priority { return ratelimit.Interactive }, 0)
+		if err != nil {
+			t.mtx.Unlock()
+			return 0, err
+		}
+		defer release()
+		stop := context.AfterFunc(ctx, func() {
+			t.mtx.Lock()
+			defer t.mtx.Unlock()
+			t.serveCond.Broadcast()
+		})
+		defer stop()
 		for !missing.IsEmpty() {
+			if err := ctx.Err(); err != nil {
+				t.mtx.Unlock()
+				return 0, err
+			}
 			t.serveCond.Wait()
 			missing = t.have.FindUncovered(offset, offset+size)
 			missingWant := t.want.FindUncoveredRange(missing)
@@ -289,6 +322,11 @@ func (h *TransferHandle) ReadAt(buf []byte, offset int64) (n int, retErr error)
 func (t *Transfer) simpleFetcher(ctx context.Context) {
 	const MAX_DOWNLOAD_SIZE = 1024 * 128
 	t.mtx.Lock()
+	if t.quitFetchers {
+		// SetLocalFile() was called before we started, and cleared t.peers.
+		t.mtx.Unlock()
+		return
+	}
 	pno := rand.Intn(len(t.peers))
 	for {
 		// We should hold t.mtx at the start of each iteration.
@@ -313,14 +351,26 @@ func (t *Transfer) simpleFetcher(ctx context.Context) {
 		}
 		t.downloading.Add(iv.Start, iv.End)
 		t.mtx.Unlock()
+		// Readahead might become wanted while we wait, at which point nobody else will download it.
+		release, err := ratelimit.AcquireDownload(ctx, t, t.priorityFunc(iv.Start, iv.End), int(iv.Size()))
+		if err != nil {
+			// Our fetchers are being killed.
+			t.mtx.Lock()
+			t.downloading.Remove(iv.Start, iv.End)
+			continue
+		}
 		pno = (pno + 1) % len(t.peers)
-		stream, err := t.peers[pno].ContentServiceClient().ReadFile(ctx, &pb.ReadFileRequest{
-			Filename: t.filename,
-			Offset:   iv.Start,
-			Rdnow:    iv.End - iv.Start,
-			Rdahead:  0,
+		rctx, cancelRead := context.WithCancel(ctx)
+		stream, err := t.peers[pno].ContentServiceClient().ReadFile(rctx, &pb.ReadFileRequest{
+			Filename:          t.filename,
+			Offset:            iv.Start,
+			Rdnow:             iv.End - iv.Start,
+			Rdahead:           0,
+			AcceptCompression: compression.Accepted(),
 		})
 		if err != nil {
+			cancelRead()
+			release()
 			t.mtx.Lock()
 			log.Printf("ReadFile(%q) from %s failed: %v", t.filename, t.peers[pno].Name, err)
 			t.want.Remove(iv.Start, iv.End)
@@ -333,6 +383,10 @@ func (t *Transfer) simpleFetcher(ctx context.Context) {
 		for {
 			// We're not holding any locks.
 			res, err := stream.Recv()
+			var data []byte
+			if err == nil {
+				data, err = compression.Decompress(res.GetCompression(), res.GetData())
+			}
 			if err != nil {
 				t.mtx.Lock()
 				if offset < iv.End {
@@ -347,8 +401,8 @@ func (t *Transfer) simpleFetcher(ctx context.Context) {
 				t.serveCond.Broadcast()
 				break
 			}
-			if len(res.Data) > 0 {
-				if _, err := t.storage.WriteAt(res.Data, offset); err != nil {
+			if len(data) > 0 {
+				if _, err := t.storage.WriteAt(data, offset); err != nil {
 					t.mtx.Lock()
 					if !strings.Contains(err.Error(), "bad file descriptor") {
 						// This happens after SetLocalFile() was called, at which point t.storage becomes readonly.
@@ -362,8 +416,8 @@ func (t *Transfer) simpleFetcher(ctx context.Context) {
 					t.serveCond.Broadcast()
 					break
 				}
-				t.receivedBytes(offset, offset+int64(len(res.Data)), "simple", t.peers[pno].Name)
-				offset += int64(len(res.Data))
+				t.receivedBytes(offset, offset+int64(len(data)), "simple", t.peers[pno].Name)
+				offset += int64(len(data))
 			}
 			if downloadId := res.GetRedirectToOrchestratedDownload(); downloadId != 0 {
 				if err := RedirectToOrchestrationCallback(t.circle, t, downloadId); err != nil {
@@ -371,9 +425,40 @@ func (t *Transfer) simpleFetcher(ctx context.Context) {
 				}
 			}
 		}
+		cancelRead()
+		release()
+	}
+}
+
+// priority returns the priority class for downloading [start, end). t.mtx must be held.
+func (t *Transfer) priority(start, end int64) ratelimit.Priority {
+	switch {
+	case overlaps(t.want, start, end):
+		return ratelimit.Interactive
+	case overlaps(t.readahead, start, end):
+		return ratelimit.Readahead
+	default:
+		return ratelimit.Background
 	}
 }
 
+// priorityFunc returns a function that returns the current priority class for downloading [start, end).
+func (t *Transfer) priorityFunc(start, end int64) func() ratelimit.Priority {
+	return func() ratelimit.Priority {
+		t.mtx.Lock()
+		defer t.mtx.Unlock()
+		return t.priority(start, end)
+	}
+}
+
+func overlaps(is intervals.Intervals, start, end int64) bool {
+	if start >= end {
+		return false
+	}
+	uncovered := is.FindUncovered(start, end)
+	return !uncovered.Has(start, end)
+}
+
 func (t *Transfer) receivedBytes(start, end int64, transferType string, peer string) {
 	t.mtx.Lock()
 	t.have.Add(start, end)
@@ -442,8 +527,8 @@ func (t *Transfer) DownloadId() int64 {
 	return t.orchestream.DownloadId
 }
 
-func (t *Transfer) HandleIncomingPassiveTransfer(stream pb.ContentService_PassiveTransferServer) error {
-	return t.passive.HandleIncomingPassiveTransfer(stream)
+func (t *Transfer) HandleIncomingPassiveTransfer(stream pb.ContentService_PassiveTransferServer, hello *pb.PassiveTransferData) error {
+	return t.passive.HandleIncomingPassiveTransfer(stream, hello)
 }
 
 func (t *Transfer) byteRangesUpdated() {
@@ -477,6 +562,10 @@ func (pc passiveCallbacks) ReceivedBytes(start, end int64, peer string) {
 	pc.t.receivedBytes(start, end, "passive", peer)
 }
 
+func (pc passiveCallbacks) WaitDownload(ctx context.Context, start, end int64) error {
+	return ratelimit.WaitDownload(ctx, pc.t, pc.t.priorityFunc(start, end), int(end-start))
+}
+
 func (pc passiveCallbacks) UploadFailed(peer string) {
 	pc.t.mtx.Lock()
 	if pc.t.orchestream != nil {
@@ -493,10 +582,42 @@ func (pc passiveCallbacks) SetConnectedPeers(peers []string) {
 	pc.t.mtx.Unlock()
 }
 
+func (pc passiveCallbacks) MayUpload(peer string) bool {
+	pc.t.mtx.Lock()
+	remote := pc.t.TransferIsRemote()
+	pc.t.mtx.Unlock()
+	if remote {
+		// We're downloading the file ourselves, so it isn't from our shares.
+		return true
+	}
+	return MayUploadCallback(pc.t.circle, peer, pc.t.filename)
+}
+
+func (pc passiveCallbacks) Uploaded(peer string, start, end int64) {
+	pc.t.mtx.Lock()
+	remote := pc.t.TransferIsRemote()
+	pc.t.mtx.Unlock()
+	if remote || UploadedCallback == nil {
+		return
+	}
+	UploadedCallback(pc.t.circle, peer, pc.t.filename, start, end)
+}
+
 func (pc passiveCallbacks) OrchestrationClosed() {
 	pc.t.switchFromOrchestratedMode()
 }
 
+// CachedBytes returns how many bytes of the file we have locally.
+func (t *Transfer) CachedBytes() int64 {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	var total int64
+	for _, iv := range t.have.Export() {
+		total += iv.Size()
+	}
+	return total
+}
+
 func (t *Transfer) GetHash() string {
 	t.mtx.Lock()
 	defer t.mtx.Unlock()
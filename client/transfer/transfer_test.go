@@ -0,0 +1,41 @@
+package transfer
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sgielen/rufs/client/connectivity"
+)
+
+func TestMayUpload(t *testing.T) {
+	var asked []string
+	MayUploadCallback = func(circle, peer, remoteFilename string) bool {
+		asked = append(asked, circle+" "+peer+" "+remoteFilename)
+		return peer == "alice@example.com"
+	}
+	defer func() {
+		MayUploadCallback = nil
+	}()
+
+	local := passiveCallbacks{&Transfer{circle: "example.com", filename: "family/photo.jpg"}}
+	if !local.MayUpload("alice@example.com") {
+		t.Errorf("MayUpload(alice) = false; want true")
+	}
+	if local.MayUpload("mallory@example.com") {
+		t.Errorf("MayUpload(mallory) = true; want false")
+	}
+	want := []string{"example.com alice@example.com family/photo.jpg", "example.com mallory@example.com family/photo.jpg"}
+	if diff := cmp.Diff(want, asked); diff != "" {
+		t.Errorf("MayUploadCallback calls differ (-want +got):\n%s", diff)
+	}
+
+	// Files we're downloading ourselves aren't from our shares, so anyone in the download may get them.
+	asked = nil
+	remote := passiveCallbacks{&Transfer{circle: "example.com", filename: "family/photo.jpg", peers: []*connectivity.Peer{{Name: "bob@example.com"}}}}
+	if !remote.MayUpload("mallory@example.com") {
+		t.Errorf("MayUpload(mallory) of a remote file = false; want true")
+	}
+	if len(asked) != 0 {
+		t.Errorf("MayUploadCallback was called for a remote file: %q", asked)
+	}
+}
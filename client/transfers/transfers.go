@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"sync"
 
 	"github.com/sgielen/rufs/client/connectivity"
@@ -32,6 +33,7 @@ func init() {
 	connectivity.HandleActiveDownloadList = HandleActiveDownloadList
 	transfer.ForgetCallback = Forget
 	transfer.RedirectToOrchestrationCallback = RedirectToOrchestration
+	transfer.MayUploadCallback = shares.MayRead
 }
 
 func getCircle(name string) *circle {
@@ -50,6 +52,7 @@ func getCircle(name string) *circle {
 }
 
 func GetTransferForFile(ctx context.Context, remoteFilename, maybeHash string, size int64, peers []*connectivity.Peer) (*transfer.Transfer, error) {
+	remoteFilename = normalizePath(remoteFilename)
 	mtx.Lock()
 	defer mtx.Unlock()
 	c := getCircle(common.CircleFromPeer(peers[0].Name))
@@ -64,6 +67,18 @@ func GetTransferForFile(ctx context.Context, remoteFilename, maybeHash string, s
 	return t, nil
 }
 
+// FindTransferForFile returns the transfer for remoteFilename if there is one.
+func FindTransferForFile(circle, remoteFilename string) *transfer.Transfer {
+	mtx.Lock()
+	defer mtx.Unlock()
+	return getCircle(circle).byRemoteFilename[normalizePath(remoteFilename)]
+}
+
+// normalizePath strips the leading slash some FUSE backends pass, so that transfers are found by the paths we list.
+func normalizePath(remoteFilename string) string {
+	return strings.Trim(remoteFilename, "/")
+}
+
 func IsLocalFileOrchestrated(circle, remoteFilename string) (int64, bool) {
 	mtx.Lock()
 	defer mtx.Unlock()
@@ -185,7 +200,7 @@ func (c *circle) hashListener(circle, remoteFilename, hash string) {
 
 func (c *circle) makeTransferWithLocalfile(t *transfer.Transfer, remoteFilename, maybeHash string) (*transfer.Transfer, error) {
 	if t == nil || t.TransferIsRemote() {
-		fh, err := shares.Open(c.name, remoteFilename)
+		fh, err := shares.Open(c.name, "", remoteFilename)
 		if err != nil {
 			return nil, fmt.Errorf("shares.Open(%q, %q): %v", c.name, remoteFilename, err)
 		}
@@ -239,7 +254,7 @@ func HandleIncomingPassiveTransfer(stream pb.ContentService_PassiveTransferServe
 		log.Printf("HandleIncomingPassiveTransfer: Refusing because we don't know %d yet", d)
 		return fmt.Errorf("download_id %d is not known (yet?) at this side, please ring later", d)
 	}
-	return t.HandleIncomingPassiveTransfer(stream)
+	return t.HandleIncomingPassiveTransfer(stream, msg)
 }
 
 func Forget(circle string, t *transfer.Transfer) {
@@ -3,11 +3,13 @@ package vfs
 import (
 	"context"
 	"errors"
+	"flag"
 	"fmt"
 	"io"
 	"log"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -31,6 +33,10 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+var (
+	showSymlinks = flag.Bool("show_symlinks", false, "Show symbolic links that peers expose as links (they're hidden otherwise)")
+)
+
 func GetFilesystem() billy.Filesystem {
 	first := true
 	fs := router.New(emptyfs.New())
@@ -56,19 +62,25 @@ type mergeFS struct{}
 
 var _ billy.Basic = mergeFS{}
 var _ billy.Dir = mergeFS{}
+var _ billy.Symlink = mergeFS{}
 
 type Directory struct {
 	Files map[string]*File
 }
 
 type File struct {
-	fullPath     string
-	isDirectory  bool
-	mtime        time.Time
-	size         int64
-	hash         string
-	peers        []*connectivity.Peer
-	fixedContent []byte
+	fullPath      string
+	isDirectory   bool
+	mtime         time.Time
+	size          int64
+	hash          string
+	symlinkTarget string
+	birthTime     time.Time
+	mode          os.FileMode
+	executable    bool
+	xattrs        map[string][]byte
+	peers         []*connectivity.Peer
+	fixedContent  []byte
 }
 
 var _ os.FileInfo = &File{}
@@ -82,12 +94,66 @@ func (f *File) IsDir() bool {
 }
 
 func (f *File) Mode() os.FileMode {
+	if f.symlinkTarget != "" {
+		return 0777 | os.ModeSymlink
+	}
 	if f.isDirectory {
 		return 0555 | os.ModeDir
 	}
+	if f.mode != 0 {
+		// The mount is read-only.
+		return f.mode & 0555
+	}
+	if f.executable {
+		return 0555
+	}
 	return 0444
 }
 
+// BirthTime returns when the file was created, or the zero time if the sharer doesn't know.
+func (f *File) BirthTime() time.Time {
+	return f.birthTime
+}
+
+// rufsXattrPrefix is the prefix of the extended attributes we add to files.
+const rufsXattrPrefix = "user.rufs."
+
+// Xattrs returns the extended attributes the sharer exposes, plus user.rufs.* attributes describing what RUFS knows
+// about the file.
+func (f *File) Xattrs() map[string][]byte {
+	ret := make(map[string][]byte, len(f.xattrs)+4)
+	for k, v := range f.xattrs {
+		// Sharers can't spoof our attributes.
+		if !strings.HasPrefix(k, rufsXattrPrefix) {
+			ret[k] = v
+		}
+	}
+	if f.isDirectory || f.symlinkTarget != "" || len(f.peers) == 0 {
+		return ret
+	}
+	peers := make([]string, len(f.peers))
+	for i, p := range f.peers {
+		peers[i] = p.Name
+	}
+	sort.Strings(peers)
+	ret[rufsXattrPrefix+"peers"] = []byte(strings.Join(peers, ","))
+	hash := f.hash
+	var cached, downloadId int64
+	if t := transfers.FindTransferForFile(common.CircleFromPeer(f.peers[0].Name), f.fullPath); t != nil {
+		if hash == "" {
+			hash = t.GetHash()
+		}
+		cached = t.CachedBytes()
+		downloadId = t.DownloadId()
+	}
+	ret[rufsXattrPrefix+"cached_bytes"] = []byte(strconv.FormatInt(cached, 10))
+	ret[rufsXattrPrefix+"download_id"] = []byte(strconv.FormatInt(downloadId, 10))
+	if hash != "" {
+		ret[rufsXattrPrefix+"hash"] = []byte(hash)
+	}
+	return ret
+}
+
 func (f *File) ModTime() time.Time {
 	return f.mtime
 }
@@ -133,6 +199,10 @@ func (mergeFS) Open(p string) (billy.File, error) {
 	if file.isDirectory {
 		return nil, errors.New("EISDIR")
 	}
+	if file.symlinkTarget != "" {
+		// The kernel follows links itself.
+		return nil, errors.New("ELOOP")
+	}
 	if len(file.fixedContent) > 0 {
 		metrics.AddVfsFixedContentOpens(connectivity.CirclesFromPeers(connectivity.AllPeers()), basename, 1)
 		return readonlyhandle.New(&fixedContentHandle{
@@ -241,6 +311,9 @@ func readdirImpl(ctx context.Context, p string, preferCache bool) *Directory {
 	}
 	for p, r := range resps {
 		for _, file := range r.Files {
+			if file.GetSymlinkTarget() != "" && !*showSymlinks {
+				continue
+			}
 			if files[file.Filename] == nil {
 				files[file.Filename] = &peerFile{}
 			}
@@ -259,7 +332,11 @@ func readdirImpl(ctx context.Context, p string, preferCache bool) *Directory {
 			hashes := map[string]bool{}
 			isDirectoryEverywhere := true
 			for _, instance := range file.instances {
-				if !instance.file.GetIsDirectory() {
+				if instance.file.GetSymlinkTarget() != "" {
+					// Links to the same target are the same.
+					isDirectoryEverywhere = false
+					hashes["symlink:"+instance.file.GetSymlinkTarget()] = true
+				} else if !instance.file.GetIsDirectory() {
 					isDirectoryEverywhere = false
 					hashes[instance.file.GetHash()] = true
 				}
@@ -278,20 +355,30 @@ func readdirImpl(ctx context.Context, p string, preferCache bool) *Directory {
 	}
 	for filename, file := range files {
 		peers := []*connectivity.Peer{}
-		var highestMtime int64
+		var highestMtime time.Time
 		for _, instance := range file.instances {
 			peers = append(peers, instance.peer)
-			if instance.file.GetMtime() > highestMtime {
-				highestMtime = instance.file.GetMtime()
+			if mtime := time.Unix(instance.file.GetMtime(), int64(instance.file.GetMtimeNanos())); mtime.After(highestMtime) {
+				highestMtime = mtime
 			}
 		}
+		first := file.instances[0].file
+		var birthTime time.Time
+		if first.GetBirthtime() != 0 || first.GetBirthtimeNanos() != 0 {
+			birthTime = time.Unix(first.GetBirthtime(), int64(first.GetBirthtimeNanos()))
+		}
 		res.Files[filename] = &File{
-			fullPath:    path.Join(p, filename),
-			isDirectory: file.instances[0].file.GetIsDirectory(),
-			mtime:       time.Unix(highestMtime, 0),
-			size:        file.instances[0].file.GetSize(),
-			hash:        file.instances[0].file.GetHash(),
-			peers:       peers,
+			fullPath:      path.Join(p, filename),
+			isDirectory:   first.GetIsDirectory(),
+			mtime:         highestMtime,
+			size:          first.GetSize(),
+			hash:          first.GetHash(),
+			symlinkTarget: first.GetSymlinkTarget(),
+			birthTime:     birthTime,
+			mode:          os.FileMode(first.GetMode()).Perm(),
+			executable:    first.GetExecutable(),
+			xattrs:        first.GetXattrs(),
+			peers:         peers,
 		}
 	}
 	if len(warnings) >= 1 {
@@ -412,3 +499,23 @@ func (mergeFS) Rename(string, string) error {
 func (mergeFS) MkdirAll(string, os.FileMode) error {
 	return os.ErrPermission
 }
+
+func (m mergeFS) Lstat(p string) (os.FileInfo, error) {
+	return m.Stat(p)
+}
+
+func (mergeFS) Symlink(string, string) error {
+	return os.ErrPermission
+}
+
+func (m mergeFS) Readlink(p string) (string, error) {
+	fi, err := m.Stat(p)
+	if err != nil {
+		return "", err
+	}
+	f, ok := fi.(*File)
+	if !ok || f.symlinkTarget == "" {
+		return "", errors.New("EINVAL")
+	}
+	return f.symlinkTarget, nil
+}
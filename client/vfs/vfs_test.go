@@ -0,0 +1,91 @@
+package vfs
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sgielen/rufs/client/config"
+	"github.com/sgielen/rufs/client/connectivity"
+	"github.com/sgielen/rufs/client/metrics"
+	"github.com/sgielen/rufs/client/transfers"
+)
+
+func TestXattrs(t *testing.T) {
+	f := &File{
+		fullPath: "music/song.mp3",
+		hash:     "abc",
+		xattrs: map[string][]byte{
+			"user.comment":   []byte("hello"),
+			"user.rufs.hash": []byte("spoofed"),
+			"user.rufs.evil": []byte("spoofed"),
+		},
+		peers: []*connectivity.Peer{{Name: "bob@example.com"}, {Name: "alice@example.com"}},
+	}
+	want := map[string]string{
+		"user.comment":           "hello",
+		"user.rufs.hash":         "abc",
+		"user.rufs.peers":        "alice@example.com,bob@example.com",
+		"user.rufs.cached_bytes": "0",
+		"user.rufs.download_id":  "0",
+	}
+	got := map[string]string{}
+	for k, v := range f.Xattrs() {
+		got[k] = string(v)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Xattrs() differs (-want +got):\n%s", diff)
+	}
+}
+
+func TestXattrsWithTransfer(t *testing.T) {
+	dir := t.TempDir()
+	cfg := filepath.Join(dir, "config.yaml")
+	if err := os.WriteFile(cfg, []byte("circles:\n- name: example.com\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := flag.Set("config", cfg); err != nil {
+		t.Fatal(err)
+	}
+	if err := config.LoadConfig(); err != nil {
+		t.Fatalf("LoadConfig() failed: %v", err)
+	}
+	metrics.Init()
+
+	peers := []*connectivity.Peer{{Name: "alice@example.com"}}
+	// Some FUSE backends open files with a leading slash.
+	tr, err := transfers.GetTransferForFile(context.Background(), "/music/unhashed.mp3", "", 0, peers)
+	if err != nil {
+		t.Fatalf("GetTransferForFile() failed: %v", err)
+	}
+	fn := filepath.Join(dir, "unhashed.mp3")
+	if err := os.WriteFile(fn, []byte("12345"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	fh, err := os.Open(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Pretend we downloaded the whole file and hashed it.
+	if err := tr.SetLocalFile(fh, "def"); err != nil {
+		t.Fatalf("SetLocalFile() failed: %v", err)
+	}
+
+	f := &File{fullPath: "music/unhashed.mp3", peers: peers}
+	want := map[string]string{
+		"user.rufs.hash":         "def",
+		"user.rufs.peers":        "alice@example.com",
+		"user.rufs.cached_bytes": "5",
+		"user.rufs.download_id":  "0",
+	}
+	got := map[string]string{}
+	for k, v := range f.Xattrs() {
+		got[k] = string(v)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Xattrs() differs (-want +got):\n%s", diff)
+	}
+}
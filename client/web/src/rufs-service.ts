@@ -65,9 +65,18 @@ export class RufsService {
     return new RufsConfig(circles);
   }
 
-  public static async register(circle: string, user: string, token: string, ca: string): Promise<void> {
+  // getNATTypes returns the kind of NAT we're behind, per circle.
+  public static async getNATTypes(): Promise<{[circle: string]: string}> {
+    const rq = await fetch('/api/nat_types');
+    if (!rq.ok) {
+      throw new Error('Failed retrieving NAT types: ' + await rqToError(rq));
+    }
+    return await rq.json();
+  }
+
+  public static async register(circle: string, user: string, device: string, token: string, ca: string): Promise<void> {
     const rq = await fetch('/api/register?' + new URLSearchParams({
-      circle, user, token, ca
+      circle, user, device, token, ca
     }));
     if (!rq.ok) {
       throw new Error('Failed to add circle: ' + await rqToError(rq));
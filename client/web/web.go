@@ -19,6 +19,7 @@ import (
 	"github.com/pkg/browser"
 	"github.com/sgielen/rufs/client/config"
 	"github.com/sgielen/rufs/client/connectivity"
+	"github.com/sgielen/rufs/client/content"
 	"github.com/sgielen/rufs/client/register"
 	"github.com/sgielen/rufs/client/shares"
 	"github.com/sgielen/rufs/client/vfs"
@@ -59,6 +60,9 @@ func Init(addr string) {
 	http.Handle("/api/add_share", convreq.Wrap(addShare, convreq.WithErrorHandler(errorHandler)))
 	http.Handle("/api/set_mountpoint", convreq.Wrap(setMountpoint, convreq.WithErrorHandler(errorHandler)))
 	http.Handle("/api/open_explorer", convreq.Wrap(openExplorer, convreq.WithErrorHandler(errorHandler)))
+	http.Handle("/api/paths", convreq.Wrap(renderPaths, convreq.WithErrorHandler(errorHandler)))
+	http.Handle("/api/audit", convreq.Wrap(renderAuditLog, convreq.WithErrorHandler(errorHandler)))
+	http.Handle("/api/nat_types", convreq.Wrap(renderNATTypes, convreq.WithErrorHandler(errorHandler)))
 	http.Handle("/rpcz", rpcz.Handler)
 	http.Handle("/", convreq.Wrap(renderStatic))
 	log.Printf("web server listening on addr %s.", addr)
@@ -149,15 +153,65 @@ func renderConfig(ctx context.Context, req *http.Request) convreq.HttpResponse {
 	return respondJSON(config.GetConfig())
 }
 
+func renderPaths(ctx context.Context, req *http.Request) convreq.HttpResponse {
+	return respondJSON(connectivity.Paths())
+}
+
+func renderNATTypes(ctx context.Context, req *http.Request) convreq.HttpResponse {
+	return respondJSON(connectivity.NATTypes())
+}
+
+type auditLogGet struct {
+	Circle string `schema:"circle"`
+	Peer   string `schema:"peer"`
+	Path   string `schema:"path"`
+	Since  string `schema:"since"`
+	Until  string `schema:"until"`
+	Limit  int    `schema:"limit"`
+}
+
+func renderAuditLog(ctx context.Context, req *http.Request, get auditLogGet) convreq.HttpResponse {
+	q := content.AuditQuery{
+		Circle: get.Circle,
+		Peer:   get.Peer,
+		Path:   get.Path,
+		Limit:  get.Limit,
+	}
+	if q.Limit <= 0 {
+		q.Limit = 1000
+	}
+	var err error
+	if get.Since != "" {
+		if q.Since, err = time.Parse(time.RFC3339, get.Since); err != nil {
+			return respond.BadRequest(fmt.Sprintf("invalid since: %v", err))
+		}
+	}
+	if get.Until != "" {
+		if q.Until, err = time.Parse(time.RFC3339, get.Until); err != nil {
+			return respond.BadRequest(fmt.Sprintf("invalid until: %v", err))
+		}
+	}
+	type Res struct {
+		Ok      bool
+		Entries []content.AuditEntry
+	}
+	entries, err := content.QueryAuditLog(q)
+	if err != nil {
+		return respond.Error(err)
+	}
+	return respondJSON(Res{true, entries})
+}
+
 type registerCircleGet struct {
 	User   string `schema:"user,required"`
+	Device string `schema:"device"`
 	Token  string `schema:"token,required"`
 	Circle string `schema:"circle,required"`
 	Ca     string `schema:"ca,required"`
 }
 
 func registerCircle(ctx context.Context, req *http.Request, get registerCircleGet) convreq.HttpResponse {
-	if err := register.Register(ctx, get.Circle, get.User, get.Token, get.Ca); err != nil {
+	if err := register.Register(ctx, get.Circle, get.User, get.Device, get.Token, get.Ca); err != nil {
 		return respond.Error(err)
 	}
 	if err := config.AddCircleAndStore(get.Circle); err != nil {
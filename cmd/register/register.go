@@ -14,6 +14,7 @@ var (
 	circle   = flag.String("circle", "", "Name of the circle to join")
 	ca       = flag.String("ca", "", "Path or URL to the CA certificate")
 	username = flag.String("user", "", "RuFS username")
+	device   = flag.String("device", "", "Optional device name, to allow being connected from multiple devices at once")
 	token    = flag.String("token", "", "Auth token given by an administrator")
 )
 
@@ -27,7 +28,7 @@ func main() {
 	}
 	config.MustResolvePath()
 
-	if err := register.Register(context.Background(), *circle, *username, *token, *ca); err != nil {
+	if err := register.Register(context.Background(), *circle, *username, *device, *token, *ca); err != nil {
 		log.Fatalf("Registration failed: %v", err)
 	}
 }
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/sgielen/rufs/proto"
+	"github.com/sgielen/rufs/security"
+	"github.com/sgielen/rufs/version"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	certdir   = flag.String("certdir", "", "Directory with ca.crt, admin.crt and admin.key (and ca.key for create_cert)")
+	discovery = flag.String("discovery", "", "Address of the discovery server (defaults to the circle name on port 12000)")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s --certdir=<dir> <command> [args]\n\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "Commands:\n")
+	fmt.Fprintf(os.Stderr, "  create_cert <name>            Create admin.crt and admin.key (needs ca.key in --certdir)\n")
+	fmt.Fprintf(os.Stderr, "  clients                       List connected clients\n")
+	fmt.Fprintf(os.Stderr, "  orchestrations                List active orchestrations\n")
+	fmt.Fprintf(os.Stderr, "  kick <peer>                   Disconnect a client\n")
+	fmt.Fprintf(os.Stderr, "  end_orchestration <id>        End an active orchestration\n")
+	fmt.Fprintf(os.Stderr, "  groups                        List groups\n")
+	fmt.Fprintf(os.Stderr, "  set_group <name> [member...]  Replace the members of a group (none to delete it)\n\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	log.Printf("starting rufs admin %s", version.GetVersion())
+
+	if *certdir == "" || flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+
+	if flag.Arg(0) == "create_cert" {
+		if flag.NArg() != 2 {
+			usage()
+			os.Exit(2)
+		}
+		if err := createCert(flag.Arg(1)); err != nil {
+			log.Fatalf("Failed to create admin certificate: %v", err)
+		}
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	c, err := connect(ctx)
+	if err != nil {
+		log.Fatalf("Failed to connect to discovery server: %v", err)
+	}
+
+	switch flag.Arg(0) {
+	case "clients":
+		err = listClients(ctx, c)
+	case "orchestrations":
+		err = listOrchestrations(ctx, c)
+	case "kick":
+		if flag.NArg() != 2 {
+			usage()
+			os.Exit(2)
+		}
+		_, err = c.KickClient(ctx, &pb.KickClientRequest{Name: flag.Arg(1)})
+	case "end_orchestration":
+		if flag.NArg() != 2 {
+			usage()
+			os.Exit(2)
+		}
+		id, perr := strconv.ParseInt(flag.Arg(1), 10, 64)
+		if perr != nil {
+			log.Fatalf("Invalid orchestration id %q: %v", flag.Arg(1), perr)
+		}
+		_, err = c.EndOrchestration(ctx, &pb.EndOrchestrationRequest{DownloadId: id})
+	case "groups":
+		err = listGroups(ctx, c)
+	case "set_group":
+		if flag.NArg() < 2 {
+			usage()
+			os.Exit(2)
+		}
+		_, err = c.SetGroup(ctx, &pb.SetGroupRequest{Group: &pb.Group{Name: flag.Arg(1), Members: flag.Args()[2:]}})
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatalf("%s failed: %v", flag.Arg(0), err)
+	}
+}
+
+func createCert(name string) error {
+	ca, err := security.LoadCAKeyPair(*certdir)
+	if err != nil {
+		return fmt.Errorf("failed to load CA key pair: %v", err)
+	}
+	key, err := security.NewKey()
+	if err != nil {
+		return fmt.Errorf("failed to generate key pair: %v", err)
+	}
+	pub, err := key.SerializePublicKey()
+	if err != nil {
+		return fmt.Errorf("failed to serialize public key: %v", err)
+	}
+	if !strings.Contains(name, "@") {
+		name = fmt.Sprintf("%s@%s", name, ca.Name())
+	}
+	crt, err := ca.SignAdmin(pub, name)
+	if err != nil {
+		return fmt.Errorf("failed to sign certificate: %v", err)
+	}
+	keyFile := filepath.Join(*certdir, "admin.key")
+	if err := key.StorePrivateKey(keyFile); err != nil {
+		return fmt.Errorf("failed to store private key: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(*certdir, "admin.crt"), crt, 0644); err != nil {
+		os.Remove(keyFile)
+		return fmt.Errorf("failed to store certificate: %v", err)
+	}
+	log.Printf("Created admin certificate for %q", name)
+	return nil
+}
+
+func connect(ctx context.Context) (pb.DiscoveryAdminServiceClient, error) {
+	caPEM, err := ioutil.ReadFile(filepath.Join(*certdir, "ca.crt"))
+	if err != nil {
+		return nil, err
+	}
+	crtPEM, err := ioutil.ReadFile(filepath.Join(*certdir, "admin.crt"))
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := ioutil.ReadFile(filepath.Join(*certdir, "admin.key"))
+	if err != nil {
+		return nil, err
+	}
+	kp, err := security.LoadKeyPair(caPEM, crtPEM, keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	addr := *discovery
+	if addr == "" {
+		addr = kp.CircleName()
+	}
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, "12000")
+	}
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(credentials.NewTLS(kp.TLSConfigForMasterClient())), grpc.WithBlock(), grpc.WithReturnConnectionError())
+	if err != nil {
+		return nil, err
+	}
+	return pb.NewDiscoveryAdminServiceClient(conn), nil
+}
+
+func listClients(ctx context.Context, c pb.DiscoveryAdminServiceClient) error {
+	resp, err := c.ListClients(ctx, &pb.ListClientsRequest{})
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tVERSION\tCONNECTED SINCE\tADDRESS\tENDPOINTS")
+	for _, cl := range resp.GetClients() {
+		var endpoints []string
+		for _, e := range cl.GetEndpoints() {
+			endpoints = append(endpoints, fmt.Sprintf("%s:%s", e.GetType(), e.GetAddress()))
+		}
+		since := time.Unix(cl.GetConnectedSince(), 0).Format(time.RFC3339)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", cl.GetName(), cl.GetClientVersion(), since, cl.GetAddress(), strings.Join(endpoints, " "))
+	}
+	return w.Flush()
+}
+
+func listOrchestrations(ctx context.Context, c pb.DiscoveryAdminServiceClient) error {
+	resp, err := c.ListOrchestrations(ctx, &pb.ListOrchestrationsRequest{})
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "DOWNLOAD ID\tHASH\tFILENAMES\tPEERS")
+	for _, o := range resp.GetOrchestrations() {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", o.GetDownloadId(), o.GetHash(), strings.Join(o.GetFilenames(), ", "), strings.Join(o.GetPeers(), ", "))
+	}
+	return w.Flush()
+}
+
+func listGroups(ctx context.Context, c pb.DiscoveryAdminServiceClient) error {
+	resp, err := c.ListGroups(ctx, &pb.ListGroupsRequest{})
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tMEMBERS")
+	for _, g := range resp.GetGroups() {
+		fmt.Fprintf(w, "%s\t%s\n", g.GetName(), strings.Join(g.GetMembers(), ", "))
+	}
+	return w.Flush()
+}
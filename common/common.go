@@ -6,6 +6,27 @@ func CircleFromPeer(peer string) string {
 	return strings.Split(peer, "@")[1]
 }
 
+// UserFromPeer returns the user part of a peer name, without the device and circle.
+// Peer names are either "user@circle" or "user+device@circle".
+func UserFromPeer(peer string) string {
+	u := strings.Split(peer, "@")[0]
+	return strings.Split(u, "+")[0]
+}
+
+// DeviceFromPeer returns the device part of a peer name, or "" if the peer has no device.
+func DeviceFromPeer(peer string) string {
+	u := strings.Split(peer, "@")[0]
+	if i := strings.Index(u, "+"); i != -1 {
+		return u[i+1:]
+	}
+	return ""
+}
+
+// PeerWithoutDevice strips the device from a peer name: "user+device@circle" becomes "user@circle".
+func PeerWithoutDevice(peer string) string {
+	return UserFromPeer(peer) + "@" + CircleFromPeer(peer)
+}
+
 func CirclesFromPeers(peers []string) []string {
 	cs := map[string]bool{}
 	for _, p := range peers {
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	pb "github.com/sgielen/rufs/proto"
+	"github.com/sgielen/rufs/security"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type adminServer struct {
+	pb.UnimplementedDiscoveryAdminServiceServer
+
+	d *discovery
+}
+
+func (a *adminServer) ListClients(ctx context.Context, req *pb.ListClientsRequest) (*pb.ListClientsResponse, error) {
+	if _, _, err := security.AdminFromContext(ctx); err != nil {
+		return nil, err
+	}
+	a.d.mtx.Lock()
+	defer a.d.mtx.Unlock()
+	ret := &pb.ListClientsResponse{}
+	for _, c := range a.d.clients {
+		ret.Clients = append(ret.Clients, &pb.ListClientsResponse_Client{
+			Name:           c.peer.GetName(),
+			ClientVersion:  c.clientVersion,
+			Endpoints:      c.peer.GetEndpoints(),
+			ConnectedSince: c.connectedSince.Unix(),
+			Address:        c.address,
+		})
+	}
+	sort.Slice(ret.Clients, func(i, j int) bool {
+		return ret.Clients[i].GetName() < ret.Clients[j].GetName()
+	})
+	return ret, nil
+}
+
+func (a *adminServer) ListOrchestrations(ctx context.Context, req *pb.ListOrchestrationsRequest) (*pb.ListOrchestrationsResponse, error) {
+	if _, _, err := security.AdminFromContext(ctx); err != nil {
+		return nil, err
+	}
+	a.d.activeOrchestrationMtx.Lock()
+	orchestrations := make([]*orchestration, 0, len(a.d.activeOrchestration))
+	for _, o := range a.d.activeOrchestration {
+		orchestrations = append(orchestrations, o)
+	}
+	a.d.activeOrchestrationMtx.Unlock()
+
+	ret := &pb.ListOrchestrationsResponse{}
+	for _, o := range orchestrations {
+		o.mtx.Lock()
+		ro := &pb.ListOrchestrationsResponse_Orchestration{
+			DownloadId: o.activeDownload.GetDownloadId(),
+			Hash:       o.activeDownload.GetHash(),
+			Filenames:  o.activeDownload.GetFilenames(),
+		}
+		for p := range o.connections {
+			ro.Peers = append(ro.Peers, p)
+		}
+		o.mtx.Unlock()
+		sort.Strings(ro.Peers)
+		ret.Orchestrations = append(ret.Orchestrations, ro)
+	}
+	sort.Slice(ret.Orchestrations, func(i, j int) bool {
+		return ret.Orchestrations[i].GetDownloadId() < ret.Orchestrations[j].GetDownloadId()
+	})
+	return ret, nil
+}
+
+func (a *adminServer) KickClient(ctx context.Context, req *pb.KickClientRequest) (*pb.KickClientResponse, error) {
+	admin, _, err := security.AdminFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a.d.mtx.Lock()
+	defer a.d.mtx.Unlock()
+	c, ok := a.d.clients[req.GetName()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "client %q is not connected", req.GetName())
+	}
+	log.Printf("Admin %s kicked client %s", admin, req.GetName())
+	c.kicked = true
+	delete(a.d.clients, req.GetName())
+	a.d.peerListChanged(req.GetName(), nil)
+	return &pb.KickClientResponse{}, nil
+}
+
+func (a *adminServer) EndOrchestration(ctx context.Context, req *pb.EndOrchestrationRequest) (*pb.EndOrchestrationResponse, error) {
+	admin, _, err := security.AdminFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a.d.activeOrchestrationMtx.Lock()
+	o, ok := a.d.activeOrchestration[req.GetDownloadId()]
+	a.d.activeOrchestrationMtx.Unlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "orchestration %d is not active", req.GetDownloadId())
+	}
+	log.Printf("Admin %s ended orchestration %d", admin, req.GetDownloadId())
+	o.mtx.Lock()
+	if !o.closing {
+		o.close()
+	}
+	o.mtx.Unlock()
+	// Wake up the cleanupThread so it notices we're closing.
+	select {
+	case o.handlesChan <- struct{}{}:
+	default:
+	}
+	a.d.broadcastNewActiveDownloads()
+	return &pb.EndOrchestrationResponse{}, nil
+}
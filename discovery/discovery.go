@@ -2,12 +2,15 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"net"
+	"os"
 	"path/filepath"
+	"regexp"
 	"runtime"
 	"strings"
 	"sync"
@@ -18,6 +21,7 @@ import (
 	"github.com/sgielen/rufs/discovery/metrics"
 	"github.com/sgielen/rufs/discovery/visualize"
 	pb "github.com/sgielen/rufs/proto"
+	"github.com/sgielen/rufs/relay"
 	"github.com/sgielen/rufs/security"
 	"github.com/sgielen/rufs/version"
 	"google.golang.org/grpc"
@@ -33,45 +37,90 @@ import (
 
 var (
 	port          = flag.Int("port", 12000, "gRPC port")
-	certdir       = flag.String("certdir", "", "Where CA certs are read from (see create_ca_pair)")
 	collectedLogs = flag.String("collected_logs_file", "", "Path to store collected logs in")
+	addresses     = flag.String("discovery_addresses", "", "Comma separated list of addresses (host:port) clients can reach us on. Clients store them and fail over between them when they reconnect")
 
 	mutexProfileFraction = flag.Int("mutex_profile_fraction", 0, "Controls the fraction of mutex contention events that are reported in the mutex profile. On average 1/rate events are reported.")
+
+	certdirs []string
 )
 
+func init() {
+	flag.Func("certdir", "Where CA certs are read from (see create_ca_pair). Can be given multiple times to host multiple circles", func(dir string) error {
+		certdirs = append(certdirs, dir)
+		return nil
+	})
+}
+
 func main() {
 	log.SetFlags(log.Ltime | log.Lshortfile | log.Lmicroseconds)
 	flag.Parse()
 	runtime.SetMutexProfileFraction(*mutexProfileFraction)
 
-	if *certdir == "" {
+	if len(certdirs) == 0 {
 		log.Fatalf("Flag --certdir is required")
 	}
 
 	log.Printf("starting rufs discovery %s", version.GetVersion())
 
-	ca, err := security.LoadCAKeyPair(*certdir)
-	if err != nil {
-		log.Fatalf("Failed to load CA key pair: %v", err)
+	r := &router{
+		circles: map[string]*discovery{},
 	}
-
-	d := &discovery{
-		ca:       ca,
-		circle:   ca.Name(),
-		clients:  map[string]*client{},
-		rotators: map[string]*rotator.Rotator{},
+	for _, dir := range certdirs {
+		ca, err := security.LoadCAKeyPair(dir)
+		if err != nil {
+			log.Fatalf("Failed to load CA key pair from %s: %v", dir, err)
+		}
+		if _, found := r.circles[ca.Name()]; found {
+			log.Fatalf("Circle %q is loaded twice", ca.Name())
+		}
+		d := &discovery{
+			ca:                  ca,
+			circle:              ca.Name(),
+			tlsConfig:           ca.TLSConfigForDiscovery(),
+			clients:             map[string]*client{},
+			punches:             map[int64]*punch{},
+			rotators:            map[string]*rotator.Rotator{},
+			activeOrchestration: map[int64]*orchestration{},
+			relay:               relay.NewServer(),
+			groupsPath:          filepath.Join(dir, groupsFile),
+		}
+		d.groups, err = loadGroups(d.groupsPath)
+		if err != nil {
+			log.Fatalf("Failed to load groups of %s: %v", d.circle, err)
+		}
+		if *collectedLogs != "" {
+			d.logDir = *collectedLogs
+			if len(certdirs) > 1 {
+				d.logDir = filepath.Join(*collectedLogs, d.circle)
+				if err := os.MkdirAll(d.logDir, 0755); err != nil {
+					log.Fatalf("Failed to create %s: %v", d.logDir, err)
+				}
+			}
+		}
+		d.cond = sync.NewCond(&d.mtx)
+		go d.keepAliver()
+		r.circles[d.circle] = d
+		if r.defaultCircle == nil {
+			r.defaultCircle = d
+		}
 	}
-	d.cond = sync.NewCond(&d.mtx)
-	go d.keepAliver()
-	s := grpc.NewServer(grpc.Creds(credentials.NewTLS(ca.TLSConfigForDiscovery())))
-	pb.RegisterDiscoveryServiceServer(s, d)
+	s := grpc.NewServer(grpc.Creds(credentials.NewTLS(r.tlsConfig())))
+	pb.RegisterDiscoveryServiceServer(s, r)
+	pb.RegisterDiscoveryAdminServiceServer(s, adminRouter{r: r})
+	pb.RegisterRelayServiceServer(s, relayRouter{r: r})
 	reflection.Register(s)
 	sock, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
-	log.Printf("Listening on port %d for circle %q", *port, d.circle)
+	for c := range r.circles {
+		log.Printf("Listening on port %d for circle %q", *port, c)
+	}
 	go RunStun(*port)
+	if *websocketPort != 0 {
+		go serveWebsocketTunnel(s)
+	}
 	go metrics.Serve()
 	if err := s.Serve(sock); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
@@ -81,15 +130,27 @@ func main() {
 type discovery struct {
 	pb.UnimplementedDiscoveryServiceServer
 
-	ca     *security.CAKeyPair
-	circle string
+	ca        *security.CAKeyPair
+	circle    string
+	tlsConfig *tls.Config
+	logDir    string
 
-	mtx     sync.Mutex
-	clients map[string]*client
-	cond    *sync.Cond
+	mtx             sync.Mutex
+	clients         map[string]*client
+	cond            *sync.Cond
+	peerListVersion int64
+	peerListChanges []peerListChange
+	punches         map[int64]*punch
+	groups          map[string][]string
+	groupsPath      string
+
+	activeOrchestrationMtx sync.Mutex
+	activeOrchestration    map[int64]*orchestration
 
 	loggingMtx sync.Mutex
 	rotators   map[string]*rotator.Rotator
+
+	relay *relay.Server
 }
 
 type client struct {
@@ -97,8 +158,21 @@ type client struct {
 	stream                  pb.DiscoveryService_ConnectServer
 	newPeerList             bool
 	newActiveDownloads      bool
+	newDiscoveryAddresses   bool
+	newGroups               bool
 	resolveConflictRequests []*pb.ResolveConflictRequest
+	punchRequests           []*pb.PunchRequest
 	nextKeepAlive           time.Time
+
+	supportsPeerListDeltas bool
+	// peerListVersion is the version of the last PeerList(Delta) sent to this client. Zero if none was sent yet.
+	peerListVersion int64
+
+	// Informational fields for the DiscoveryAdminService.
+	clientVersion  string
+	connectedSince time.Time
+	address        string
+	kicked         bool
 }
 
 func (d *discovery) broadcastNewActiveDownloads() {
@@ -142,6 +216,11 @@ func (d *discovery) Connect(req *pb.ConnectRequest, stream pb.DiscoveryService_C
 		}
 	}
 
+	var address string
+	if p, ok := peer.FromContext(stream.Context()); ok {
+		address = p.Addr.String()
+	}
+
 	d.mtx.Lock()
 	defer d.mtx.Unlock()
 	c := &client{
@@ -149,42 +228,49 @@ func (d *discovery) Connect(req *pb.ConnectRequest, stream pb.DiscoveryService_C
 			Name:         name,
 			OldEndpoints: oldEndpoints,
 			Endpoints:    endpoints,
+			NatType:      req.GetNatType(),
 		},
-		stream:             stream,
-		newPeerList:        true,
-		newActiveDownloads: true,
+		stream:                 stream,
+		newPeerList:            true,
+		newActiveDownloads:     true,
+		newDiscoveryAddresses:  *addresses != "",
+		newGroups:              true,
+		supportsPeerListDeltas: req.GetSupportsPeerListDeltas(),
+		clientVersion:          req.GetClientVersion(),
+		connectedSince:         time.Now(),
+		address:                address,
 	}
 	d.clients[name] = c
-	for _, c2 := range d.clients {
-		c2.newPeerList = true
-	}
-	d.cond.Broadcast()
+	d.peerListChanged(name, c.peer)
 	defer func() {
 		if d.clients[name] == c {
 			delete(d.clients, name)
-			for _, c2 := range d.clients {
-				c2.newPeerList = true
-			}
-			d.cond.Broadcast()
+			d.peerListChanged(name, nil)
 		}
 	}()
 
 	for {
 		now := time.Now()
-		for c.newPeerList || c.newActiveDownloads || len(c.resolveConflictRequests) > 0 || c.nextKeepAlive.Before(now) {
+		for c.newPeerList || c.newActiveDownloads || c.newDiscoveryAddresses || c.newGroups || len(c.resolveConflictRequests) > 0 || len(c.punchRequests) > 0 || c.nextKeepAlive.Before(now) {
 			msg := &pb.ConnectResponse{}
-			if len(c.resolveConflictRequests) > 0 {
+			if len(c.punchRequests) > 0 {
+				// Punch requests go first, because both peers should get them at the same time.
+				msg.Msg = &pb.ConnectResponse_PunchRequest{
+					PunchRequest: c.punchRequests[0],
+				}
+				c.punchRequests = c.punchRequests[1:]
+			} else if len(c.resolveConflictRequests) > 0 {
 				msg.Msg = &pb.ConnectResponse_ResolveConflictRequest{
 					ResolveConflictRequest: c.resolveConflictRequests[0],
 				}
 				c.resolveConflictRequests = c.resolveConflictRequests[1:]
 			} else if c.newActiveDownloads {
-				activeOrchestrationMtx.Lock()
-				active := make([]*pb.ConnectResponse_ActiveDownload, 0, len(activeOrchestration))
-				for _, ao := range activeOrchestration {
+				d.activeOrchestrationMtx.Lock()
+				active := make([]*pb.ConnectResponse_ActiveDownload, 0, len(d.activeOrchestration))
+				for _, ao := range d.activeOrchestration {
 					active = append(active, ao.activeDownload)
 				}
-				activeOrchestrationMtx.Unlock()
+				d.activeOrchestrationMtx.Unlock()
 				msg.Msg = &pb.ConnectResponse_ActiveDownloads{
 					ActiveDownloads: &pb.ConnectResponse_ActiveDownloadList{
 						ActiveDownloads: active,
@@ -192,13 +278,22 @@ func (d *discovery) Connect(req *pb.ConnectRequest, stream pb.DiscoveryService_C
 				}
 				c.newActiveDownloads = false
 			} else if c.newPeerList {
-				msg.Msg = &pb.ConnectResponse_PeerList_{
-					PeerList: &pb.ConnectResponse_PeerList{},
+				msg = d.peerListMessage(c)
+				c.newPeerList = false
+			} else if c.newDiscoveryAddresses {
+				msg.Msg = &pb.ConnectResponse_DiscoveryAddresses_{
+					DiscoveryAddresses: &pb.ConnectResponse_DiscoveryAddresses{
+						Addresses: discoveryAddresses(),
+					},
 				}
-				for _, c2 := range d.clients {
-					msg.GetPeerList().Peers = append(msg.GetPeerList().Peers, c2.peer)
+				c.newDiscoveryAddresses = false
+			} else if c.newGroups {
+				msg.Msg = &pb.ConnectResponse_Groups{
+					Groups: &pb.ConnectResponse_GroupList{
+						Groups: d.groupList(),
+					},
 				}
-				c.newPeerList = false
+				c.newGroups = false
 			} else {
 				// Send a keep alive message without any content.
 			}
@@ -209,24 +304,58 @@ func (d *discovery) Connect(req *pb.ConnectRequest, stream pb.DiscoveryService_C
 				return err
 			}
 			d.mtx.Lock()
-			if d.clients[name] != c {
-				return errors.New("connection from another process for your username")
+			if err := c.replacedError(d.clients[name]); err != nil {
+				return err
 			}
 		}
 
 		d.cond.Wait()
-		if d.clients[name] != c {
-			return errors.New("connection from another process for your username")
+		if err := c.replacedError(d.clients[name]); err != nil {
+			return err
+		}
+	}
+}
+
+// discoveryAddresses returns the addresses from --discovery_addresses.
+func discoveryAddresses() []string {
+	var ret []string
+	for _, a := range strings.Split(*addresses, ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			ret = append(ret, a)
 		}
 	}
+	return ret
 }
 
+// replacedError returns an error if c is no longer the active client for its name.
+func (c *client) replacedError(active *client) error {
+	if c.kicked {
+		return status.Error(codes.Aborted, "disconnected by an administrator")
+	}
+	if active != c {
+		return errors.New("connection from another process for your username")
+	}
+	return nil
+}
+
+var validDevice = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
 func (d *discovery) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
 	token := d.ca.CreateToken(req.GetUsername())
 	if token != req.GetToken() {
 		return nil, status.Error(codes.PermissionDenied, "token is incorrect")
 	}
-	cert, err := d.ca.Sign(req.GetPublicKey(), fmt.Sprintf("%s@%s", req.GetUsername(), d.circle))
+	if strings.ContainsAny(req.GetUsername(), "+@") {
+		return nil, status.Error(codes.InvalidArgument, "username can't contain '+' or '@'")
+	}
+	name := req.GetUsername()
+	if req.GetDevice() != "" {
+		if !validDevice.MatchString(req.GetDevice()) {
+			return nil, status.Error(codes.InvalidArgument, "device name must consist of letters, digits, '-' and '_'")
+		}
+		name += "+" + req.GetDevice()
+	}
+	cert, err := d.ca.Sign(req.GetPublicKey(), fmt.Sprintf("%s@%s", name, d.circle))
 	if err != nil {
 		return nil, err
 	}
@@ -276,7 +405,7 @@ func (d *discovery) PushMetrics(ctx context.Context, req *pb.PushMetricsRequest)
 }
 
 func (d *discovery) PushLogs(ctx context.Context, req *pb.PushLogsRequest) (*pb.PushLogsResponse, error) {
-	if *collectedLogs == "" {
+	if d.logDir == "" {
 		return &pb.PushLogsResponse{
 			StopSendingLogs: true,
 		}, nil
@@ -292,7 +421,7 @@ func (d *discovery) PushLogs(ctx context.Context, req *pb.PushLogsRequest) (*pb.
 
 	if d.rotators[peer] == nil {
 		filename := strings.Split(peer, "@")[0] + ".log"
-		r, err := rotator.New(filepath.Join(*collectedLogs, filename), 10*1024, false, 10)
+		r, err := rotator.New(filepath.Join(d.logDir, filename), 10*1024, false, 10)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	pb "github.com/sgielen/rufs/proto"
+	"github.com/sgielen/rufs/security"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// groupsFile is where the groups of a circle are stored, in its certdir.
+const groupsFile = "groups.yaml"
+
+var validGroup = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+func loadGroups(path string) (map[string][]string, error) {
+	groups := map[string][]string{}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return groups, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, &groups); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+	return groups, nil
+}
+
+// saveGroups writes the groups to disk. d.mtx must be held.
+func (d *discovery) saveGroups() error {
+	data, err := yaml.Marshal(d.groups)
+	if err != nil {
+		return err
+	}
+	tmp := d.groupsPath + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, d.groupsPath)
+}
+
+// groupList returns all groups sorted by name. d.mtx must be held.
+func (d *discovery) groupList() []*pb.Group {
+	ret := make([]*pb.Group, 0, len(d.groups))
+	for name, members := range d.groups {
+		ret = append(ret, &pb.Group{
+			Name:    name,
+			Members: members,
+		})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].GetName() < ret[j].GetName()
+	})
+	return ret
+}
+
+func (a *adminServer) ListGroups(ctx context.Context, req *pb.ListGroupsRequest) (*pb.ListGroupsResponse, error) {
+	if _, _, err := security.AdminFromContext(ctx); err != nil {
+		return nil, err
+	}
+	a.d.mtx.Lock()
+	defer a.d.mtx.Unlock()
+	return &pb.ListGroupsResponse{Groups: a.d.groupList()}, nil
+}
+
+func (a *adminServer) SetGroup(ctx context.Context, req *pb.SetGroupRequest) (*pb.SetGroupResponse, error) {
+	admin, _, err := security.AdminFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name := req.GetGroup().GetName()
+	if !validGroup.MatchString(name) {
+		return nil, status.Error(codes.InvalidArgument, "group name must consist of letters, digits, '-' and '_'")
+	}
+	var members []string
+	for _, m := range req.GetGroup().GetMembers() {
+		if !strings.Contains(m, "@") {
+			m += "@" + a.d.circle
+		}
+		if !strings.HasSuffix(m, "@"+a.d.circle) {
+			return nil, status.Errorf(codes.InvalidArgument, "member %q is not in circle %s", m, a.d.circle)
+		}
+		members = append(members, m)
+	}
+	sort.Strings(members)
+
+	a.d.mtx.Lock()
+	defer a.d.mtx.Unlock()
+	old, existed := a.d.groups[name]
+	if len(members) == 0 {
+		delete(a.d.groups, name)
+	} else {
+		a.d.groups[name] = members
+	}
+	if err := a.d.saveGroups(); err != nil {
+		if existed {
+			a.d.groups[name] = old
+		} else {
+			delete(a.d.groups, name)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to store groups: %v", err)
+	}
+	log.Printf("Admin %s set members of group %q to %v", admin, name, members)
+	for _, c := range a.d.clients {
+		c.newGroups = true
+	}
+	a.d.cond.Broadcast()
+	return &pb.SetGroupResponse{}, nil
+}
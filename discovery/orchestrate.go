@@ -17,9 +17,6 @@ import (
 )
 
 var (
-	activeOrchestrationMtx sync.Mutex
-	activeOrchestration    = map[int64]*orchestration{}
-
 	schedulerBusynessMetric = metrics.NewBusynessMetric("orchestrate_scheduler", []string{"orchestration"})
 )
 
@@ -61,12 +58,12 @@ func (d *discovery) Orchestrate(stream pb.DiscoveryService_OrchestrateServer) er
 		return errors.New("expected start_orchestration to be set")
 	}
 	log.Printf("Orchestrate [%s] StartOrchestration: %s", peer, msg.GetStartOrchestration())
-	activeOrchestrationMtx.Lock()
-	o, ok := activeOrchestration[msg.GetStartOrchestration().GetDownloadId()]
+	d.activeOrchestrationMtx.Lock()
+	o, ok := d.activeOrchestration[msg.GetStartOrchestration().GetDownloadId()]
 	if !ok {
-		for _, ao := range activeOrchestration {
+		for _, ao := range d.activeOrchestration {
 			if msg.GetStartOrchestration().GetHash() == ao.activeDownload.GetHash() && msg.GetStartOrchestration().GetHash() != "" {
-				activeOrchestrationMtx.Unlock()
+				d.activeOrchestrationMtx.Unlock()
 				return fmt.Errorf("attempt to start new orchestration for active download of hash %q", msg.GetStartOrchestration().GetHash())
 			}
 		}
@@ -89,7 +86,7 @@ func (d *discovery) Orchestrate(stream pb.DiscoveryService_OrchestrateServer) er
 			o.activeDownload.DownloadId = msg.GetStartOrchestration().GetDownloadId()
 		}
 		o.schedulerBusyness = schedulerBusynessMetric.Instance([]string{fmt.Sprint(o.activeDownload.DownloadId)})
-		activeOrchestration[o.activeDownload.GetDownloadId()] = o
+		d.activeOrchestration[o.activeDownload.GetDownloadId()] = o
 		d.broadcastNewActiveDownloads()
 		go o.schedulerThread()
 		go o.cleanupThread()
@@ -99,7 +96,7 @@ func (d *discovery) Orchestrate(stream pb.DiscoveryService_OrchestrateServer) er
 		o.activeDownload = nad
 		d.broadcastNewActiveDownloads()
 	}
-	activeOrchestrationMtx.Unlock()
+	d.activeOrchestrationMtx.Unlock()
 	log.Printf("Orchestrate{%d} [%s] Sending: welcome:{download_id: %d}", o.activeDownload.GetDownloadId(), peer, o.activeDownload.GetDownloadId())
 	if err := stream.Send(&pb.OrchestrateResponse{
 		Msg: &pb.OrchestrateResponse_Welcome_{
@@ -314,9 +311,9 @@ func (o *orchestration) cleanupThread() {
 }
 
 func (o *orchestration) close() {
-	activeOrchestrationMtx.Lock()
-	delete(activeOrchestration, o.activeDownload.DownloadId)
-	activeOrchestrationMtx.Unlock()
+	o.discovery.activeOrchestrationMtx.Lock()
+	delete(o.discovery.activeOrchestration, o.activeDownload.DownloadId)
+	o.discovery.activeOrchestrationMtx.Unlock()
 
 	o.closing = true
 	o.schedCond.Broadcast()
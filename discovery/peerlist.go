@@ -0,0 +1,92 @@
+package main
+
+import (
+	pb "github.com/sgielen/rufs/proto"
+)
+
+// maxPeerListChanges is the number of peer list changes we remember for sending deltas. Clients that are further
+// behind get a full PeerList instead.
+const maxPeerListChanges = 1000
+
+type peerListChange struct {
+	version int64
+	name    string
+	// peer is nil if the peer disconnected.
+	peer *pb.Peer
+}
+
+// peerListChanged records that a peer connected, reconnected or disconnected (if p is nil) and notifies all clients.
+// d.mtx must be held.
+func (d *discovery) peerListChanged(name string, p *pb.Peer) {
+	d.peerListVersion++
+	d.peerListChanges = append(d.peerListChanges, peerListChange{
+		version: d.peerListVersion,
+		name:    name,
+		peer:    p,
+	})
+	if len(d.peerListChanges) > maxPeerListChanges {
+		d.peerListChanges = d.peerListChanges[len(d.peerListChanges)-maxPeerListChanges:]
+	}
+	for _, c := range d.clients {
+		c.newPeerList = true
+	}
+	d.cond.Broadcast()
+}
+
+// peerListMessage returns the message that brings c up to date with the current peer list. d.mtx must be held.
+func (d *discovery) peerListMessage(c *client) *pb.ConnectResponse {
+	if c.supportsPeerListDeltas && c.peerListVersion > 0 {
+		if delta := d.peerListDelta(c.peerListVersion); delta != nil {
+			c.peerListVersion = delta.GetVersion()
+			return &pb.ConnectResponse{
+				Msg: &pb.ConnectResponse_PeerListDelta_{
+					PeerListDelta: delta,
+				},
+			}
+		}
+	}
+	pl := &pb.ConnectResponse_PeerList{
+		Version: d.peerListVersion,
+	}
+	for _, c2 := range d.clients {
+		pl.Peers = append(pl.Peers, c2.peer)
+	}
+	c.peerListVersion = d.peerListVersion
+	return &pb.ConnectResponse{
+		Msg: &pb.ConnectResponse_PeerList_{
+			PeerList: pl,
+		},
+	}
+}
+
+// peerListDelta returns the changes since the given version, or nil if we no longer know all those changes.
+// d.mtx must be held.
+func (d *discovery) peerListDelta(since int64) *pb.ConnectResponse_PeerListDelta {
+	if len(d.peerListChanges) == 0 || d.peerListChanges[0].version > since+1 {
+		return nil
+	}
+	// Only send the latest state of each peer that changed.
+	latest := map[string]*pb.Peer{}
+	var order []string
+	for _, ch := range d.peerListChanges {
+		if ch.version <= since {
+			continue
+		}
+		if _, seen := latest[ch.name]; !seen {
+			order = append(order, ch.name)
+		}
+		latest[ch.name] = ch.peer
+	}
+	delta := &pb.ConnectResponse_PeerListDelta{
+		PreviousVersion: since,
+		Version:         d.peerListVersion,
+	}
+	for _, name := range order {
+		if p := latest[name]; p != nil {
+			delta.UpdatedPeers = append(delta.UpdatedPeers, p)
+		} else {
+			delta.RemovedPeers = append(delta.RemovedPeers, name)
+		}
+	}
+	return delta
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	pb "github.com/sgielen/rufs/proto"
+	"github.com/sgielen/rufs/security"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// punchDelay gives the PunchRequests time to arrive at both peers before they start punching.
+	punchDelay    = 250 * time.Millisecond
+	punchAttempts = 10
+	punchInterval = 200 * time.Millisecond
+	// punchCooldown is how long a punch between two peers is remembered. Requests for the same pair are
+	// deduplicated during this time, and peers should report their results within it.
+	punchCooldown = 30 * time.Second
+)
+
+var (
+	punchRequestsMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "rufs",
+		Name:      "punch_requests_total",
+		Help:      "Number of coordinated hole punches between two peers",
+	}, []string{"circle"})
+	punchResultsMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "rufs",
+		Name:      "punch_results_total",
+		Help:      "Number of hole punching results reported by peers (success, failure or unreported)",
+	}, []string{"circle", "result"})
+)
+
+type punch struct {
+	id       int64
+	peers    [2]string
+	started  time.Time
+	reported map[string]bool
+}
+
+func (p *punch) involves(a, b string) bool {
+	return (p.peers[0] == a && p.peers[1] == b) || (p.peers[0] == b && p.peers[1] == a)
+}
+
+func (p *punch) isParticipant(name string) bool {
+	return p.peers[0] == name || p.peers[1] == name
+}
+
+func udpEndpoints(p *pb.Peer) []*pb.Endpoint {
+	var ret []*pb.Endpoint
+	for _, e := range p.GetEndpoints() {
+		if e.GetType() == pb.Endpoint_SCTP_OVER_UDP || e.GetType() == pb.Endpoint_QUIC {
+			ret = append(ret, e)
+		}
+	}
+	return ret
+}
+
+func (d *discovery) RequestPunch(ctx context.Context, req *pb.RequestPunchRequest) (*pb.RequestPunchResponse, error) {
+	name, _, err := security.PeerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	d.expirePunches()
+	for _, p := range d.punches {
+		if p.involves(name, req.GetPeer()) {
+			// The other side probably asked for the same punch.
+			return &pb.RequestPunchResponse{PunchId: p.id}, nil
+		}
+	}
+	a, ok := d.clients[name]
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "you are not connected")
+	}
+	b, ok := d.clients[req.GetPeer()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "%s is not connected", req.GetPeer())
+	}
+	aEndpoints := udpEndpoints(a.peer)
+	bEndpoints := udpEndpoints(b.peer)
+	if len(aEndpoints) == 0 || len(bEndpoints) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "both peers need UDP endpoints to punch")
+	}
+	p := &punch{
+		id:       rand.Int63(),
+		peers:    [2]string{name, req.GetPeer()},
+		started:  time.Now(),
+		reported: map[string]bool{},
+	}
+	d.punches[p.id] = p
+	a.punchRequests = append(a.punchRequests, newPunchRequest(p.id, req.GetPeer(), bEndpoints))
+	b.punchRequests = append(b.punchRequests, newPunchRequest(p.id, name, aEndpoints))
+	d.cond.Broadcast()
+	punchRequestsMetric.WithLabelValues(d.circle).Inc()
+	return &pb.RequestPunchResponse{PunchId: p.id}, nil
+}
+
+func newPunchRequest(id int64, peer string, endpoints []*pb.Endpoint) *pb.PunchRequest {
+	return &pb.PunchRequest{
+		PunchId:        id,
+		Peer:           peer,
+		Endpoints:      endpoints,
+		DelayMillis:    int32(punchDelay / time.Millisecond),
+		Attempts:       punchAttempts,
+		IntervalMillis: int32(punchInterval / time.Millisecond),
+	}
+}
+
+func (d *discovery) ReportPunchResult(ctx context.Context, req *pb.ReportPunchResultRequest) (*pb.ReportPunchResultResponse, error) {
+	name, _, err := security.PeerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	p, ok := d.punches[req.GetPunchId()]
+	if !ok || !p.isParticipant(name) {
+		return nil, status.Errorf(codes.NotFound, "punch %d not found", req.GetPunchId())
+	}
+	if p.reported[name] {
+		return &pb.ReportPunchResultResponse{}, nil
+	}
+	p.reported[name] = true
+	result := "failure"
+	if req.GetSuccess() {
+		result = "success"
+	}
+	punchResultsMetric.WithLabelValues(d.circle, result).Inc()
+	log.Printf("Punch between %s and %s: %s reported %s (endpoint %s)", p.peers[0], p.peers[1], name, result, req.GetEndpoint().GetAddress())
+	return &pb.ReportPunchResultResponse{}, nil
+}
+
+// expirePunches forgets punches that are older than punchCooldown. d.mtx must be held.
+func (d *discovery) expirePunches() {
+	for id, p := range d.punches {
+		if time.Since(p.started) < punchCooldown {
+			continue
+		}
+		for _, peer := range p.peers {
+			if !p.reported[peer] {
+				punchResultsMetric.WithLabelValues(d.circle, "unreported").Inc()
+			}
+		}
+		delete(d.punches, id)
+	}
+}
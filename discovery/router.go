@@ -0,0 +1,223 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"strings"
+
+	pb "github.com/sgielen/rufs/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// router serves multiple circles on a single port. The circle is picked based on the TLS ServerName the client
+// sends, which is the circle name. Each circle has its own CA, so the handshake only succeeds if we present the
+// right certificate. Because client certificates are verified against that same CA, we can trust the ServerName
+// to pick the circle for every RPC on the connection.
+type router struct {
+	pb.UnimplementedDiscoveryServiceServer
+
+	circles       map[string]*discovery
+	defaultCircle *discovery
+}
+
+func (r *router) tlsConfig() *tls.Config {
+	return &tls.Config{
+		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			return r.circleForServerName(hello.ServerName).tlsConfig, nil
+		},
+	}
+}
+
+// circleForServerName returns the circle the client wants to talk to. Clients send the full circle name (which
+// can include a port) when connecting, but only the hostname during registration.
+func (r *router) circleForServerName(serverName string) *discovery {
+	if d, ok := r.circles[serverName]; ok {
+		return d
+	}
+	for name, d := range r.circles {
+		host, _, err := net.SplitHostPort(name)
+		if err != nil {
+			host = name
+		}
+		if strings.EqualFold(host, serverName) {
+			return d
+		}
+	}
+	return r.defaultCircle
+}
+
+func (r *router) circleFromContext(ctx context.Context) (*discovery, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		// This should never happen.
+		return nil, status.Error(codes.Internal, "no Peer attached to context")
+	}
+	ti, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "couldn't get TLSInfo; TLS issue?")
+	}
+	return r.circleForServerName(ti.State.ServerName), nil
+}
+
+func (r *router) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
+	d, err := r.circleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return d.Register(ctx, req)
+}
+
+func (r *router) Connect(req *pb.ConnectRequest, stream pb.DiscoveryService_ConnectServer) error {
+	d, err := r.circleFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	return d.Connect(req, stream)
+}
+
+func (r *router) GetMyIP(ctx context.Context, req *pb.GetMyIPRequest) (*pb.GetMyIPResponse, error) {
+	d, err := r.circleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return d.GetMyIP(ctx, req)
+}
+
+func (r *router) ResolveConflict(ctx context.Context, req *pb.ResolveConflictRequest) (*pb.ResolveConflictResponse, error) {
+	d, err := r.circleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return d.ResolveConflict(ctx, req)
+}
+
+func (r *router) Orchestrate(stream pb.DiscoveryService_OrchestrateServer) error {
+	d, err := r.circleFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	return d.Orchestrate(stream)
+}
+
+func (r *router) PushMetrics(ctx context.Context, req *pb.PushMetricsRequest) (*pb.PushMetricsResponse, error) {
+	d, err := r.circleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return d.PushMetrics(ctx, req)
+}
+
+func (r *router) PushLogs(ctx context.Context, req *pb.PushLogsRequest) (*pb.PushLogsResponse, error) {
+	d, err := r.circleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return d.PushLogs(ctx, req)
+}
+
+func (r *router) RequestPunch(ctx context.Context, req *pb.RequestPunchRequest) (*pb.RequestPunchResponse, error) {
+	d, err := r.circleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return d.RequestPunch(ctx, req)
+}
+
+func (r *router) ReportPunchResult(ctx context.Context, req *pb.ReportPunchResultRequest) (*pb.ReportPunchResultResponse, error) {
+	d, err := r.circleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return d.ReportPunchResult(ctx, req)
+}
+
+// adminRouter routes DiscoveryAdminService calls to the right circle. Admin certificates are only valid for the
+// circle whose CA signed them.
+type adminRouter struct {
+	pb.UnimplementedDiscoveryAdminServiceServer
+
+	r *router
+}
+
+func (a adminRouter) adminServer(ctx context.Context) (*adminServer, error) {
+	d, err := a.r.circleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &adminServer{d: d}, nil
+}
+
+func (a adminRouter) ListClients(ctx context.Context, req *pb.ListClientsRequest) (*pb.ListClientsResponse, error) {
+	s, err := a.adminServer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.ListClients(ctx, req)
+}
+
+func (a adminRouter) ListOrchestrations(ctx context.Context, req *pb.ListOrchestrationsRequest) (*pb.ListOrchestrationsResponse, error) {
+	s, err := a.adminServer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.ListOrchestrations(ctx, req)
+}
+
+func (a adminRouter) KickClient(ctx context.Context, req *pb.KickClientRequest) (*pb.KickClientResponse, error) {
+	s, err := a.adminServer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.KickClient(ctx, req)
+}
+
+func (a adminRouter) EndOrchestration(ctx context.Context, req *pb.EndOrchestrationRequest) (*pb.EndOrchestrationResponse, error) {
+	s, err := a.adminServer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.EndOrchestration(ctx, req)
+}
+
+func (a adminRouter) ListGroups(ctx context.Context, req *pb.ListGroupsRequest) (*pb.ListGroupsResponse, error) {
+	s, err := a.adminServer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.ListGroups(ctx, req)
+}
+
+func (a adminRouter) SetGroup(ctx context.Context, req *pb.SetGroupRequest) (*pb.SetGroupResponse, error) {
+	s, err := a.adminServer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.SetGroup(ctx, req)
+}
+
+// relayRouter routes RelayService calls to the relay of the right circle.
+type relayRouter struct {
+	pb.UnimplementedRelayServiceServer
+
+	r *router
+}
+
+func (rr relayRouter) Listen(req *pb.RelayListenRequest, stream pb.RelayService_ListenServer) error {
+	d, err := rr.r.circleFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	return d.relay.Listen(req, stream)
+}
+
+func (rr relayRouter) Connect(stream pb.RelayService_ConnectServer) error {
+	d, err := rr.r.circleFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	return d.relay.Connect(stream)
+}
@@ -1,30 +1,82 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"log"
 	"net"
+
+	"github.com/sgielen/rufs/stunlite"
+)
+
+var (
+	stunAlternatePort = flag.Int("stun_alternate_port", 0, "Second UDP port to answer stunlite requests from, used by clients to detect their NAT type (default --port+1)")
+	stunAlternateIP   = flag.String("stun_alternate_ip", "", "Second IP address of this server to answer stunlite requests from, used by clients to detect full cone NATs")
 )
 
 func RunStun(port int) {
-	laddr, err := net.ResolveUDPAddr("udp4", fmt.Sprintf(":%d", port))
+	altPort := *stunAlternatePort
+	if altPort == 0 {
+		altPort = port + 1
+	}
+	// Listen on both IPv4 and IPv6 if the system supports it.
+	primary := listenUDP("", port)
+	alternate := listenUDP("", altPort)
+	var changeAddress *net.UDPConn
+	var altAddr string
+	if *stunAlternateIP != "" {
+		// Any port works, because this socket only sends replies.
+		changeAddress = listenUDP(*stunAlternateIP, 0)
+		altAddr = changeAddress.LocalAddr().String()
+	}
+	info := stunlite.FormatInfo(altPort, altAddr)
+	go serveStun(alternate, primary, changeAddress, info)
+	serveStun(primary, alternate, changeAddress, info)
+}
+
+func listenUDP(ip string, port int) *net.UDPConn {
+	laddr, err := net.ResolveUDPAddr("udp", net.JoinHostPort(ip, fmt.Sprint(port)))
 	if err != nil {
 		panic(err)
 	}
-	sock, err := net.ListenUDP("udp4", laddr)
+	sock, err := net.ListenUDP("udp", laddr)
 	if err != nil {
 		panic(err)
 	}
+	return sock
+}
+
+// serveStun answers stunlite requests arriving on sock. otherPort is the socket on the other port, and changeAddress
+// is the socket on the alternate IP address (or nil).
+func serveStun(sock, otherPort, changeAddress *net.UDPConn, info []byte) {
+	buf := make([]byte, 16)
 	for {
-		_, addr, err := sock.ReadFromUDP(nil)
+		n, addr, err := sock.ReadFromUDP(buf)
 		if err != nil {
 			panic(err)
 		}
 		if addr == nil {
 			continue
 		}
-		_, err = sock.WriteToUDP([]byte(addr.String()), addr)
-		if err != nil {
+		reply := []byte(addr.String())
+		from := sock
+		if n > 0 {
+			switch buf[0] {
+			case stunlite.Echo:
+			case stunlite.ChangePort:
+				from = otherPort
+			case stunlite.ChangeAddress:
+				if changeAddress == nil {
+					continue
+				}
+				from = changeAddress
+			case stunlite.Info:
+				reply = info
+			default:
+				continue
+			}
+		}
+		if _, err := from.WriteToUDP(reply, addr); err != nil {
 			log.Printf("Failed to respond to stunlite packet: %v", err)
 		}
 	}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+
+	"github.com/sgielen/rufs/wstunnel"
+	"google.golang.org/grpc"
+)
+
+var (
+	websocketPort = flag.Int("websocket_port", 0, "Port to accept gRPC connections tunneled over WebSocket on, for clients in networks that only allow HTTP(S). 0 to disable")
+	websocketCert = flag.String("websocket_cert", "", "TLS certificate file for --websocket_port. Without it the tunnel is served over plain HTTP, but the connections inside it are still encrypted")
+	websocketKey  = flag.String("websocket_key", "", "TLS key file for --websocket_cert")
+)
+
+// serveWebsocketTunnel serves s to clients that tunnel to us over WebSocket. They reach their peers through the relay.
+func serveWebsocketTunnel(s *grpc.Server) {
+	addr := fmt.Sprintf(":%d", *websocketPort)
+	tcpAddr, err := net.ResolveTCPAddr("tcp", addr)
+	if err != nil {
+		log.Fatalf("Failed to resolve %q: %v", addr, err)
+	}
+	l := wstunnel.NewListener(tcpAddr)
+	go func() {
+		if err := s.Serve(l); err != nil {
+			log.Fatalf("Failed to serve WebSocket tunnels: %v", err)
+		}
+	}()
+	mux := http.NewServeMux()
+	mux.Handle(wstunnel.Path, l)
+	srv := &http.Server{Addr: addr, Handler: mux}
+	log.Printf("Accepting WebSocket tunnels on port %d", *websocketPort)
+	if *websocketCert != "" {
+		err = srv.ListenAndServeTLS(*websocketCert, *websocketKey)
+	} else {
+		err = srv.ListenAndServe()
+	}
+	log.Fatalf("Failed to serve WebSocket tunnels: %v", err)
+}
@@ -21,12 +21,128 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// NATType is the kind of NAT a peer is behind. Hole punching between two
+// peers doesn't work if one is behind a symmetric NAT and the other is behind
+// a symmetric or port-restricted NAT.
+type NATType int32
+
+const (
+	NATType_UNKNOWN_NAT NATType = 0
+	// Not behind a NAT.
+	NATType_OPEN            NATType = 1
+	NATType_FULL_CONE       NATType = 2
+	NATType_RESTRICTED      NATType = 3
+	NATType_PORT_RESTRICTED NATType = 4
+	NATType_SYMMETRIC       NATType = 5
+)
+
+// Enum value maps for NATType.
+var (
+	NATType_name = map[int32]string{
+		0: "UNKNOWN_NAT",
+		1: "OPEN",
+		2: "FULL_CONE",
+		3: "RESTRICTED",
+		4: "PORT_RESTRICTED",
+		5: "SYMMETRIC",
+	}
+	NATType_value = map[string]int32{
+		"UNKNOWN_NAT":     0,
+		"OPEN":            1,
+		"FULL_CONE":       2,
+		"RESTRICTED":      3,
+		"PORT_RESTRICTED": 4,
+		"SYMMETRIC":       5,
+	}
+)
+
+func (x NATType) Enum() *NATType {
+	p := new(NATType)
+	*p = x
+	return p
+}
+
+func (x NATType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NATType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rufs_proto_enumTypes[0].Descriptor()
+}
+
+func (NATType) Type() protoreflect.EnumType {
+	return &file_rufs_proto_enumTypes[0]
+}
+
+func (x NATType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NATType.Descriptor instead.
+func (NATType) EnumDescriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{0}
+}
+
+type Compression int32
+
+const (
+	Compression_UNCOMPRESSED Compression = 0
+	Compression_ZSTD         Compression = 1
+)
+
+// Enum value maps for Compression.
+var (
+	Compression_name = map[int32]string{
+		0: "UNCOMPRESSED",
+		1: "ZSTD",
+	}
+	Compression_value = map[string]int32{
+		"UNCOMPRESSED": 0,
+		"ZSTD":         1,
+	}
+)
+
+func (x Compression) Enum() *Compression {
+	p := new(Compression)
+	*p = x
+	return p
+}
+
+func (x Compression) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Compression) Descriptor() protoreflect.EnumDescriptor {
+	return file_rufs_proto_enumTypes[1].Descriptor()
+}
+
+func (Compression) Type() protoreflect.EnumType {
+	return &file_rufs_proto_enumTypes[1]
+}
+
+func (x Compression) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Compression.Descriptor instead.
+func (Compression) EnumDescriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{1}
+}
+
 type Endpoint_Type int32
 
 const (
 	Endpoint_UNKNOWN_TYPE  Endpoint_Type = 0
 	Endpoint_TCP           Endpoint_Type = 1
 	Endpoint_SCTP_OVER_UDP Endpoint_Type = 2
+	// The address is the circle name. The peer is reachable through the
+	// RelayService of that circle's discovery server.
+	Endpoint_RELAY Endpoint_Type = 3
+	// gRPC over QUIC streams, on the same UDP socket as SCTP_OVER_UDP.
+	Endpoint_QUIC Endpoint_Type = 4
+	// gRPC over a WebSocket tunnel, for networks that only allow HTTP(S).
+	// The address is a ws:// or wss:// URL.
+	Endpoint_WEBSOCKET Endpoint_Type = 5
 )
 
 // Enum value maps for Endpoint_Type.
@@ -35,11 +151,17 @@ var (
 		0: "UNKNOWN_TYPE",
 		1: "TCP",
 		2: "SCTP_OVER_UDP",
+		3: "RELAY",
+		4: "QUIC",
+		5: "WEBSOCKET",
 	}
 	Endpoint_Type_value = map[string]int32{
 		"UNKNOWN_TYPE":  0,
 		"TCP":           1,
 		"SCTP_OVER_UDP": 2,
+		"RELAY":         3,
+		"QUIC":          4,
+		"WEBSOCKET":     5,
 	}
 )
 
@@ -54,11 +176,11 @@ func (x Endpoint_Type) String() string {
 }
 
 func (Endpoint_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_rufs_proto_enumTypes[0].Descriptor()
+	return file_rufs_proto_enumTypes[2].Descriptor()
 }
 
 func (Endpoint_Type) Type() protoreflect.EnumType {
-	return &file_rufs_proto_enumTypes[0]
+	return &file_rufs_proto_enumTypes[2]
 }
 
 func (x Endpoint_Type) Number() protoreflect.EnumNumber {
@@ -67,7 +189,7 @@ func (x Endpoint_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Endpoint_Type.Descriptor instead.
 func (Endpoint_Type) EnumDescriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{6, 0}
+	return file_rufs_proto_rawDescGZIP(), []int{11, 0}
 }
 
 type PushMetricsRequest_MetricType int32
@@ -109,11 +231,11 @@ func (x PushMetricsRequest_MetricType) String() string {
 }
 
 func (PushMetricsRequest_MetricType) Descriptor() protoreflect.EnumDescriptor {
-	return file_rufs_proto_enumTypes[1].Descriptor()
+	return file_rufs_proto_enumTypes[3].Descriptor()
 }
 
 func (PushMetricsRequest_MetricType) Type() protoreflect.EnumType {
-	return &file_rufs_proto_enumTypes[1]
+	return &file_rufs_proto_enumTypes[3]
 }
 
 func (x PushMetricsRequest_MetricType) Number() protoreflect.EnumNumber {
@@ -122,7 +244,7 @@ func (x PushMetricsRequest_MetricType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PushMetricsRequest_MetricType.Descriptor instead.
 func (PushMetricsRequest_MetricType) EnumDescriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{13, 0}
+	return file_rufs_proto_rawDescGZIP(), []int{19, 0}
 }
 
 type PushMetricsRequest_MetricId int32
@@ -209,11 +331,11 @@ func (x PushMetricsRequest_MetricId) String() string {
 }
 
 func (PushMetricsRequest_MetricId) Descriptor() protoreflect.EnumDescriptor {
-	return file_rufs_proto_enumTypes[2].Descriptor()
+	return file_rufs_proto_enumTypes[4].Descriptor()
 }
 
 func (PushMetricsRequest_MetricId) Type() protoreflect.EnumType {
-	return &file_rufs_proto_enumTypes[2]
+	return &file_rufs_proto_enumTypes[4]
 }
 
 func (x PushMetricsRequest_MetricId) Number() protoreflect.EnumNumber {
@@ -222,7 +344,7 @@ func (x PushMetricsRequest_MetricId) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PushMetricsRequest_MetricId.Descriptor instead.
 func (PushMetricsRequest_MetricId) EnumDescriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{13, 1}
+	return file_rufs_proto_rawDescGZIP(), []int{19, 1}
 }
 
 type RegisterRequest struct {
@@ -234,6 +356,9 @@ type RegisterRequest struct {
 	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	PublicKey     []byte `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	ClientVersion string `protobuf:"bytes,4,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	// Optional. If set, the certificate is issued for "username+device@circle",
+	// so the same user can be connected from multiple devices at once.
+	Device string `protobuf:"bytes,5,opt,name=device,proto3" json:"device,omitempty"`
 }
 
 func (x *RegisterRequest) Reset() {
@@ -296,6 +421,13 @@ func (x *RegisterRequest) GetClientVersion() string {
 	return ""
 }
 
+func (x *RegisterRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
 type RegisterResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -351,6 +483,11 @@ type ConnectRequest struct {
 	OldEndpoints  []string    `protobuf:"bytes,1,rep,name=old_endpoints,json=oldEndpoints,proto3" json:"old_endpoints,omitempty"`
 	ClientVersion string      `protobuf:"bytes,2,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
 	Endpoints     []*Endpoint `protobuf:"bytes,3,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	// Whether the client understands ConnectResponse.PeerListDelta.
+	SupportsPeerListDeltas bool `protobuf:"varint,4,opt,name=supports_peer_list_deltas,json=supportsPeerListDeltas,proto3" json:"supports_peer_list_deltas,omitempty"`
+	// The type of NAT the client is behind, as detected with the stunlite
+	// server.
+	NatType NATType `protobuf:"varint,5,opt,name=nat_type,json=natType,proto3,enum=NATType" json:"nat_type,omitempty"`
 }
 
 func (x *ConnectRequest) Reset() {
@@ -406,6 +543,20 @@ func (x *ConnectRequest) GetEndpoints() []*Endpoint {
 	return nil
 }
 
+func (x *ConnectRequest) GetSupportsPeerListDeltas() bool {
+	if x != nil {
+		return x.SupportsPeerListDeltas
+	}
+	return false
+}
+
+func (x *ConnectRequest) GetNatType() NATType {
+	if x != nil {
+		return x.NatType
+	}
+	return NATType_UNKNOWN_NAT
+}
+
 type ConnectResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -415,6 +566,10 @@ type ConnectResponse struct {
 	//	*ConnectResponse_PeerList_
 	//	*ConnectResponse_ActiveDownloads
 	//	*ConnectResponse_ResolveConflictRequest
+	//	*ConnectResponse_PeerListDelta_
+	//	*ConnectResponse_PunchRequest
+	//	*ConnectResponse_DiscoveryAddresses_
+	//	*ConnectResponse_Groups
 	Msg isConnectResponse_Msg `protobuf_oneof:"msg"`
 }
 
@@ -478,6 +633,34 @@ func (x *ConnectResponse) GetResolveConflictRequest() *ResolveConflictRequest {
 	return nil
 }
 
+func (x *ConnectResponse) GetPeerListDelta() *ConnectResponse_PeerListDelta {
+	if x, ok := x.GetMsg().(*ConnectResponse_PeerListDelta_); ok {
+		return x.PeerListDelta
+	}
+	return nil
+}
+
+func (x *ConnectResponse) GetPunchRequest() *PunchRequest {
+	if x, ok := x.GetMsg().(*ConnectResponse_PunchRequest); ok {
+		return x.PunchRequest
+	}
+	return nil
+}
+
+func (x *ConnectResponse) GetDiscoveryAddresses() *ConnectResponse_DiscoveryAddresses {
+	if x, ok := x.GetMsg().(*ConnectResponse_DiscoveryAddresses_); ok {
+		return x.DiscoveryAddresses
+	}
+	return nil
+}
+
+func (x *ConnectResponse) GetGroups() *ConnectResponse_GroupList {
+	if x, ok := x.GetMsg().(*ConnectResponse_Groups); ok {
+		return x.Groups
+	}
+	return nil
+}
+
 type isConnectResponse_Msg interface {
 	isConnectResponse_Msg()
 }
@@ -494,20 +677,55 @@ type ConnectResponse_ResolveConflictRequest struct {
 	ResolveConflictRequest *ResolveConflictRequest `protobuf:"bytes,3,opt,name=resolve_conflict_request,json=resolveConflictRequest,proto3,oneof"`
 }
 
+type ConnectResponse_PeerListDelta_ struct {
+	PeerListDelta *ConnectResponse_PeerListDelta `protobuf:"bytes,4,opt,name=peer_list_delta,json=peerListDelta,proto3,oneof"`
+}
+
+type ConnectResponse_PunchRequest struct {
+	PunchRequest *PunchRequest `protobuf:"bytes,5,opt,name=punch_request,json=punchRequest,proto3,oneof"`
+}
+
+type ConnectResponse_DiscoveryAddresses_ struct {
+	DiscoveryAddresses *ConnectResponse_DiscoveryAddresses `protobuf:"bytes,6,opt,name=discovery_addresses,json=discoveryAddresses,proto3,oneof"`
+}
+
+type ConnectResponse_Groups struct {
+	Groups *ConnectResponse_GroupList `protobuf:"bytes,7,opt,name=groups,proto3,oneof"`
+}
+
 func (*ConnectResponse_PeerList_) isConnectResponse_Msg() {}
 
 func (*ConnectResponse_ActiveDownloads) isConnectResponse_Msg() {}
 
 func (*ConnectResponse_ResolveConflictRequest) isConnectResponse_Msg() {}
 
-type GetMyIPRequest struct {
+func (*ConnectResponse_PeerListDelta_) isConnectResponse_Msg() {}
+
+func (*ConnectResponse_PunchRequest) isConnectResponse_Msg() {}
+
+func (*ConnectResponse_DiscoveryAddresses_) isConnectResponse_Msg() {}
+
+func (*ConnectResponse_Groups) isConnectResponse_Msg() {}
+
+// PunchRequest is sent to both peers at the same time. They should start
+// sending packets to each other's endpoints after delay_millis.
+type PunchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PunchId int64  `protobuf:"varint,1,opt,name=punch_id,json=punchId,proto3" json:"punch_id,omitempty"`
+	Peer    string `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	// The UDP endpoints of the other peer.
+	Endpoints   []*Endpoint `protobuf:"bytes,3,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	DelayMillis int32       `protobuf:"varint,4,opt,name=delay_millis,json=delayMillis,proto3" json:"delay_millis,omitempty"`
+	// How many packets to send to each endpoint, and the time between them.
+	Attempts       int32 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	IntervalMillis int32 `protobuf:"varint,6,opt,name=interval_millis,json=intervalMillis,proto3" json:"interval_millis,omitempty"`
 }
 
-func (x *GetMyIPRequest) Reset() {
-	*x = GetMyIPRequest{}
+func (x *PunchRequest) Reset() {
+	*x = PunchRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rufs_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -515,13 +733,13 @@ func (x *GetMyIPRequest) Reset() {
 	}
 }
 
-func (x *GetMyIPRequest) String() string {
+func (x *PunchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyIPRequest) ProtoMessage() {}
+func (*PunchRequest) ProtoMessage() {}
 
-func (x *GetMyIPRequest) ProtoReflect() protoreflect.Message {
+func (x *PunchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rufs_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -533,21 +751,63 @@ func (x *GetMyIPRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyIPRequest.ProtoReflect.Descriptor instead.
-func (*GetMyIPRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PunchRequest.ProtoReflect.Descriptor instead.
+func (*PunchRequest) Descriptor() ([]byte, []int) {
 	return file_rufs_proto_rawDescGZIP(), []int{4}
 }
 
-type GetMyIPResponse struct {
+func (x *PunchRequest) GetPunchId() int64 {
+	if x != nil {
+		return x.PunchId
+	}
+	return 0
+}
+
+func (x *PunchRequest) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *PunchRequest) GetEndpoints() []*Endpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+func (x *PunchRequest) GetDelayMillis() int32 {
+	if x != nil {
+		return x.DelayMillis
+	}
+	return 0
+}
+
+func (x *PunchRequest) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *PunchRequest) GetIntervalMillis() int32 {
+	if x != nil {
+		return x.IntervalMillis
+	}
+	return 0
+}
+
+type RequestPunchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ip string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Peer string `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
 }
 
-func (x *GetMyIPResponse) Reset() {
-	*x = GetMyIPResponse{}
+func (x *RequestPunchRequest) Reset() {
+	*x = RequestPunchRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rufs_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -555,13 +815,13 @@ func (x *GetMyIPResponse) Reset() {
 	}
 }
 
-func (x *GetMyIPResponse) String() string {
+func (x *RequestPunchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyIPResponse) ProtoMessage() {}
+func (*RequestPunchRequest) ProtoMessage() {}
 
-func (x *GetMyIPResponse) ProtoReflect() protoreflect.Message {
+func (x *RequestPunchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rufs_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -573,29 +833,28 @@ func (x *GetMyIPResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyIPResponse.ProtoReflect.Descriptor instead.
-func (*GetMyIPResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestPunchRequest.ProtoReflect.Descriptor instead.
+func (*RequestPunchRequest) Descriptor() ([]byte, []int) {
 	return file_rufs_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetMyIPResponse) GetIp() string {
+func (x *RequestPunchRequest) GetPeer() string {
 	if x != nil {
-		return x.Ip
+		return x.Peer
 	}
 	return ""
 }
 
-type Endpoint struct {
+type RequestPunchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type    Endpoint_Type `protobuf:"varint,1,opt,name=type,proto3,enum=Endpoint_Type" json:"type,omitempty"`
-	Address string        `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	PunchId int64 `protobuf:"varint,1,opt,name=punch_id,json=punchId,proto3" json:"punch_id,omitempty"`
 }
 
-func (x *Endpoint) Reset() {
-	*x = Endpoint{}
+func (x *RequestPunchResponse) Reset() {
+	*x = RequestPunchResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rufs_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -603,13 +862,13 @@ func (x *Endpoint) Reset() {
 	}
 }
 
-func (x *Endpoint) String() string {
+func (x *RequestPunchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Endpoint) ProtoMessage() {}
+func (*RequestPunchResponse) ProtoMessage() {}
 
-func (x *Endpoint) ProtoReflect() protoreflect.Message {
+func (x *RequestPunchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rufs_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -621,37 +880,31 @@ func (x *Endpoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Endpoint.ProtoReflect.Descriptor instead.
-func (*Endpoint) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestPunchResponse.ProtoReflect.Descriptor instead.
+func (*RequestPunchResponse) Descriptor() ([]byte, []int) {
 	return file_rufs_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Endpoint) GetType() Endpoint_Type {
-	if x != nil {
-		return x.Type
-	}
-	return Endpoint_UNKNOWN_TYPE
-}
-
-func (x *Endpoint) GetAddress() string {
+func (x *RequestPunchResponse) GetPunchId() int64 {
 	if x != nil {
-		return x.Address
+		return x.PunchId
 	}
-	return ""
+	return 0
 }
 
-type Peer struct {
+type ReportPunchResultRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	OldEndpoints []string    `protobuf:"bytes,2,rep,name=old_endpoints,json=oldEndpoints,proto3" json:"old_endpoints,omitempty"`
-	Endpoints    []*Endpoint `protobuf:"bytes,3,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	PunchId int64 `protobuf:"varint,1,opt,name=punch_id,json=punchId,proto3" json:"punch_id,omitempty"`
+	Success bool  `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// The endpoint we reached the other peer on, if successful.
+	Endpoint *Endpoint `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
 }
 
-func (x *Peer) Reset() {
-	*x = Peer{}
+func (x *ReportPunchResultRequest) Reset() {
+	*x = ReportPunchResultRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rufs_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -659,13 +912,13 @@ func (x *Peer) Reset() {
 	}
 }
 
-func (x *Peer) String() string {
+func (x *ReportPunchResultRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Peer) ProtoMessage() {}
+func (*ReportPunchResultRequest) ProtoMessage() {}
 
-func (x *Peer) ProtoReflect() protoreflect.Message {
+func (x *ReportPunchResultRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rufs_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -677,42 +930,40 @@ func (x *Peer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Peer.ProtoReflect.Descriptor instead.
-func (*Peer) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReportPunchResultRequest.ProtoReflect.Descriptor instead.
+func (*ReportPunchResultRequest) Descriptor() ([]byte, []int) {
 	return file_rufs_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Peer) GetName() string {
+func (x *ReportPunchResultRequest) GetPunchId() int64 {
 	if x != nil {
-		return x.Name
+		return x.PunchId
 	}
-	return ""
+	return 0
 }
 
-func (x *Peer) GetOldEndpoints() []string {
+func (x *ReportPunchResultRequest) GetSuccess() bool {
 	if x != nil {
-		return x.OldEndpoints
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *Peer) GetEndpoints() []*Endpoint {
+func (x *ReportPunchResultRequest) GetEndpoint() *Endpoint {
 	if x != nil {
-		return x.Endpoints
+		return x.Endpoint
 	}
 	return nil
 }
 
-type ResolveConflictRequest struct {
+type ReportPunchResultResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
 }
 
-func (x *ResolveConflictRequest) Reset() {
-	*x = ResolveConflictRequest{}
+func (x *ReportPunchResultResponse) Reset() {
+	*x = ReportPunchResultResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rufs_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -720,13 +971,13 @@ func (x *ResolveConflictRequest) Reset() {
 	}
 }
 
-func (x *ResolveConflictRequest) String() string {
+func (x *ReportPunchResultResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveConflictRequest) ProtoMessage() {}
+func (*ReportPunchResultResponse) ProtoMessage() {}
 
-func (x *ResolveConflictRequest) ProtoReflect() protoreflect.Message {
+func (x *ReportPunchResultResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rufs_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -738,26 +989,19 @@ func (x *ResolveConflictRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveConflictRequest.ProtoReflect.Descriptor instead.
-func (*ResolveConflictRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReportPunchResultResponse.ProtoReflect.Descriptor instead.
+func (*ReportPunchResultResponse) Descriptor() ([]byte, []int) {
 	return file_rufs_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ResolveConflictRequest) GetFilename() string {
-	if x != nil {
-		return x.Filename
-	}
-	return ""
-}
-
-type ResolveConflictResponse struct {
+type GetMyIPRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ResolveConflictResponse) Reset() {
-	*x = ResolveConflictResponse{}
+func (x *GetMyIPRequest) Reset() {
+	*x = GetMyIPRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rufs_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -765,13 +1009,13 @@ func (x *ResolveConflictResponse) Reset() {
 	}
 }
 
-func (x *ResolveConflictResponse) String() string {
+func (x *GetMyIPRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveConflictResponse) ProtoMessage() {}
+func (*GetMyIPRequest) ProtoMessage() {}
 
-func (x *ResolveConflictResponse) ProtoReflect() protoreflect.Message {
+func (x *GetMyIPRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rufs_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -783,28 +1027,21 @@ func (x *ResolveConflictResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveConflictResponse.ProtoReflect.Descriptor instead.
-func (*ResolveConflictResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMyIPRequest.ProtoReflect.Descriptor instead.
+func (*GetMyIPRequest) Descriptor() ([]byte, []int) {
 	return file_rufs_proto_rawDescGZIP(), []int{9}
 }
 
-type OrchestrateRequest struct {
+type GetMyIPResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Msg:
-	//	*OrchestrateRequest_StartOrchestration
-	//	*OrchestrateRequest_UpdateByteRanges_
-	//	*OrchestrateRequest_ConnectedPeers_
-	//	*OrchestrateRequest_UploadFailed_
-	//	*OrchestrateRequest_SetHash_
-	//	*OrchestrateRequest_HaveOpenHandles_
-	Msg isOrchestrateRequest_Msg `protobuf_oneof:"msg"`
+	Ip string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
 }
 
-func (x *OrchestrateRequest) Reset() {
-	*x = OrchestrateRequest{}
+func (x *GetMyIPResponse) Reset() {
+	*x = GetMyIPResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rufs_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -812,13 +1049,13 @@ func (x *OrchestrateRequest) Reset() {
 	}
 }
 
-func (x *OrchestrateRequest) String() string {
+func (x *GetMyIPResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrchestrateRequest) ProtoMessage() {}
+func (*GetMyIPResponse) ProtoMessage() {}
 
-func (x *OrchestrateRequest) ProtoReflect() protoreflect.Message {
+func (x *GetMyIPResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rufs_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -830,129 +1067,101 @@ func (x *OrchestrateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrchestrateRequest.ProtoReflect.Descriptor instead.
-func (*OrchestrateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMyIPResponse.ProtoReflect.Descriptor instead.
+func (*GetMyIPResponse) Descriptor() ([]byte, []int) {
 	return file_rufs_proto_rawDescGZIP(), []int{10}
 }
 
-func (m *OrchestrateRequest) GetMsg() isOrchestrateRequest_Msg {
-	if m != nil {
-		return m.Msg
+func (x *GetMyIPResponse) GetIp() string {
+	if x != nil {
+		return x.Ip
 	}
-	return nil
+	return ""
 }
 
-func (x *OrchestrateRequest) GetStartOrchestration() *OrchestrateRequest_StartOrchestrationRequest {
-	if x, ok := x.GetMsg().(*OrchestrateRequest_StartOrchestration); ok {
-		return x.StartOrchestration
-	}
-	return nil
-}
+type Endpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *OrchestrateRequest) GetUpdateByteRanges() *OrchestrateRequest_UpdateByteRanges {
-	if x, ok := x.GetMsg().(*OrchestrateRequest_UpdateByteRanges_); ok {
-		return x.UpdateByteRanges
-	}
-	return nil
+	Type    Endpoint_Type `protobuf:"varint,1,opt,name=type,proto3,enum=Endpoint_Type" json:"type,omitempty"`
+	Address string        `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *OrchestrateRequest) GetConnectedPeers() *OrchestrateRequest_ConnectedPeers {
-	if x, ok := x.GetMsg().(*OrchestrateRequest_ConnectedPeers_); ok {
-		return x.ConnectedPeers
+func (x *Endpoint) Reset() {
+	*x = Endpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *OrchestrateRequest) GetUploadFailed() *OrchestrateRequest_UploadFailed {
-	if x, ok := x.GetMsg().(*OrchestrateRequest_UploadFailed_); ok {
-		return x.UploadFailed
-	}
-	return nil
+func (x *Endpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *OrchestrateRequest) GetSetHash() *OrchestrateRequest_SetHash {
-	if x, ok := x.GetMsg().(*OrchestrateRequest_SetHash_); ok {
-		return x.SetHash
-	}
-	return nil
-}
+func (*Endpoint) ProtoMessage() {}
 
-func (x *OrchestrateRequest) GetHaveOpenHandles() *OrchestrateRequest_HaveOpenHandles {
-	if x, ok := x.GetMsg().(*OrchestrateRequest_HaveOpenHandles_); ok {
-		return x.HaveOpenHandles
+func (x *Endpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
-}
-
-type isOrchestrateRequest_Msg interface {
-	isOrchestrateRequest_Msg()
-}
-
-type OrchestrateRequest_StartOrchestration struct {
-	StartOrchestration *OrchestrateRequest_StartOrchestrationRequest `protobuf:"bytes,1,opt,name=start_orchestration,json=startOrchestration,proto3,oneof"`
-}
-
-type OrchestrateRequest_UpdateByteRanges_ struct {
-	UpdateByteRanges *OrchestrateRequest_UpdateByteRanges `protobuf:"bytes,2,opt,name=update_byte_ranges,json=updateByteRanges,proto3,oneof"`
-}
-
-type OrchestrateRequest_ConnectedPeers_ struct {
-	ConnectedPeers *OrchestrateRequest_ConnectedPeers `protobuf:"bytes,3,opt,name=connected_peers,json=connectedPeers,proto3,oneof"`
+	return mi.MessageOf(x)
 }
 
-type OrchestrateRequest_UploadFailed_ struct {
-	UploadFailed *OrchestrateRequest_UploadFailed `protobuf:"bytes,4,opt,name=upload_failed,json=uploadFailed,proto3,oneof"`
+// Deprecated: Use Endpoint.ProtoReflect.Descriptor instead.
+func (*Endpoint) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{11}
 }
 
-type OrchestrateRequest_SetHash_ struct {
-	SetHash *OrchestrateRequest_SetHash `protobuf:"bytes,5,opt,name=set_hash,json=setHash,proto3,oneof"`
+func (x *Endpoint) GetType() Endpoint_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Endpoint_UNKNOWN_TYPE
 }
 
-type OrchestrateRequest_HaveOpenHandles_ struct {
-	HaveOpenHandles *OrchestrateRequest_HaveOpenHandles `protobuf:"bytes,6,opt,name=have_open_handles,json=haveOpenHandles,proto3,oneof"`
+func (x *Endpoint) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
 }
 
-func (*OrchestrateRequest_StartOrchestration) isOrchestrateRequest_Msg() {}
-
-func (*OrchestrateRequest_UpdateByteRanges_) isOrchestrateRequest_Msg() {}
-
-func (*OrchestrateRequest_ConnectedPeers_) isOrchestrateRequest_Msg() {}
-
-func (*OrchestrateRequest_UploadFailed_) isOrchestrateRequest_Msg() {}
-
-func (*OrchestrateRequest_SetHash_) isOrchestrateRequest_Msg() {}
-
-func (*OrchestrateRequest_HaveOpenHandles_) isOrchestrateRequest_Msg() {}
-
-type OrchestrateResponse struct {
+type Peer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Msg:
-	//	*OrchestrateResponse_Welcome_
-	//	*OrchestrateResponse_PeerList_
-	//	*OrchestrateResponse_UploadCommand_
-	Msg isOrchestrateResponse_Msg `protobuf_oneof:"msg"`
+	Name         string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	OldEndpoints []string    `protobuf:"bytes,2,rep,name=old_endpoints,json=oldEndpoints,proto3" json:"old_endpoints,omitempty"`
+	Endpoints    []*Endpoint `protobuf:"bytes,3,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	NatType      NATType     `protobuf:"varint,4,opt,name=nat_type,json=natType,proto3,enum=NATType" json:"nat_type,omitempty"`
 }
 
-func (x *OrchestrateResponse) Reset() {
-	*x = OrchestrateResponse{}
+func (x *Peer) Reset() {
+	*x = Peer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[11]
+		mi := &file_rufs_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OrchestrateResponse) String() string {
+func (x *Peer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrchestrateResponse) ProtoMessage() {}
+func (*Peer) ProtoMessage() {}
 
-func (x *OrchestrateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[11]
+func (x *Peer) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -963,87 +1172,71 @@ func (x *OrchestrateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrchestrateResponse.ProtoReflect.Descriptor instead.
-func (*OrchestrateResponse) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use Peer.ProtoReflect.Descriptor instead.
+func (*Peer) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{12}
 }
 
-func (m *OrchestrateResponse) GetMsg() isOrchestrateResponse_Msg {
-	if m != nil {
-		return m.Msg
+func (x *Peer) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *OrchestrateResponse) GetWelcome() *OrchestrateResponse_Welcome {
-	if x, ok := x.GetMsg().(*OrchestrateResponse_Welcome_); ok {
-		return x.Welcome
+func (x *Peer) GetOldEndpoints() []string {
+	if x != nil {
+		return x.OldEndpoints
 	}
 	return nil
 }
 
-func (x *OrchestrateResponse) GetPeerList() *OrchestrateResponse_PeerList {
-	if x, ok := x.GetMsg().(*OrchestrateResponse_PeerList_); ok {
-		return x.PeerList
+func (x *Peer) GetEndpoints() []*Endpoint {
+	if x != nil {
+		return x.Endpoints
 	}
 	return nil
 }
 
-func (x *OrchestrateResponse) GetUploadCommand() *OrchestrateResponse_UploadCommand {
-	if x, ok := x.GetMsg().(*OrchestrateResponse_UploadCommand_); ok {
-		return x.UploadCommand
+func (x *Peer) GetNatType() NATType {
+	if x != nil {
+		return x.NatType
 	}
-	return nil
-}
-
-type isOrchestrateResponse_Msg interface {
-	isOrchestrateResponse_Msg()
-}
-
-type OrchestrateResponse_Welcome_ struct {
-	Welcome *OrchestrateResponse_Welcome `protobuf:"bytes,1,opt,name=welcome,proto3,oneof"`
+	return NATType_UNKNOWN_NAT
 }
 
-type OrchestrateResponse_PeerList_ struct {
-	PeerList *OrchestrateResponse_PeerList `protobuf:"bytes,2,opt,name=peer_list,json=peerList,proto3,oneof"`
-}
-
-type OrchestrateResponse_UploadCommand_ struct {
-	UploadCommand *OrchestrateResponse_UploadCommand `protobuf:"bytes,3,opt,name=upload_command,json=uploadCommand,proto3,oneof"`
-}
-
-func (*OrchestrateResponse_Welcome_) isOrchestrateResponse_Msg() {}
-
-func (*OrchestrateResponse_PeerList_) isOrchestrateResponse_Msg() {}
-
-func (*OrchestrateResponse_UploadCommand_) isOrchestrateResponse_Msg() {}
-
-type Range struct {
+// LanAnnouncement is multicast on the local network, so peers in the same
+// network can connect to each other directly instead of through NAT.
+type LanAnnouncement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
-	End   int64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	// Serialized Payload.
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// DER encoded certificate of the peer, issued by the circle CA.
+	Certificate []byte `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// Signature over payload by the key of the certificate.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *Range) Reset() {
-	*x = Range{}
+func (x *LanAnnouncement) Reset() {
+	*x = LanAnnouncement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[12]
+		mi := &file_rufs_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Range) String() string {
+func (x *LanAnnouncement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Range) ProtoMessage() {}
+func (*LanAnnouncement) ProtoMessage() {}
 
-func (x *Range) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[12]
+func (x *LanAnnouncement) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1054,50 +1247,57 @@ func (x *Range) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Range.ProtoReflect.Descriptor instead.
-func (*Range) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use LanAnnouncement.ProtoReflect.Descriptor instead.
+func (*LanAnnouncement) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Range) GetStart() int64 {
+func (x *LanAnnouncement) GetPayload() []byte {
 	if x != nil {
-		return x.Start
+		return x.Payload
 	}
-	return 0
+	return nil
 }
 
-func (x *Range) GetEnd() int64 {
+func (x *LanAnnouncement) GetCertificate() []byte {
 	if x != nil {
-		return x.End
+		return x.Certificate
 	}
-	return 0
+	return nil
 }
 
-type PushMetricsRequest struct {
+func (x *LanAnnouncement) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type ResolveConflictRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Metrics []*PushMetricsRequest_Metric `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
 }
 
-func (x *PushMetricsRequest) Reset() {
-	*x = PushMetricsRequest{}
+func (x *ResolveConflictRequest) Reset() {
+	*x = ResolveConflictRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[13]
+		mi := &file_rufs_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PushMetricsRequest) String() string {
+func (x *ResolveConflictRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushMetricsRequest) ProtoMessage() {}
+func (*ResolveConflictRequest) ProtoMessage() {}
 
-func (x *PushMetricsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[13]
+func (x *ResolveConflictRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1108,41 +1308,1597 @@ func (x *PushMetricsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushMetricsRequest.ProtoReflect.Descriptor instead.
-func (*PushMetricsRequest) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ResolveConflictRequest.ProtoReflect.Descriptor instead.
+func (*ResolveConflictRequest) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *PushMetricsRequest) GetMetrics() []*PushMetricsRequest_Metric {
+func (x *ResolveConflictRequest) GetFilename() string {
 	if x != nil {
-		return x.Metrics
+		return x.Filename
+	}
+	return ""
+}
+
+type ResolveConflictResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResolveConflictResponse) Reset() {
+	*x = ResolveConflictResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveConflictResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveConflictResponse) ProtoMessage() {}
+
+func (x *ResolveConflictResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveConflictResponse.ProtoReflect.Descriptor instead.
+func (*ResolveConflictResponse) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{15}
+}
+
+type OrchestrateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Msg:
+	//	*OrchestrateRequest_StartOrchestration
+	//	*OrchestrateRequest_UpdateByteRanges_
+	//	*OrchestrateRequest_ConnectedPeers_
+	//	*OrchestrateRequest_UploadFailed_
+	//	*OrchestrateRequest_SetHash_
+	//	*OrchestrateRequest_HaveOpenHandles_
+	Msg isOrchestrateRequest_Msg `protobuf_oneof:"msg"`
+}
+
+func (x *OrchestrateRequest) Reset() {
+	*x = OrchestrateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrchestrateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrchestrateRequest) ProtoMessage() {}
+
+func (x *OrchestrateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrchestrateRequest.ProtoReflect.Descriptor instead.
+func (*OrchestrateRequest) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{16}
+}
+
+func (m *OrchestrateRequest) GetMsg() isOrchestrateRequest_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (x *OrchestrateRequest) GetStartOrchestration() *OrchestrateRequest_StartOrchestrationRequest {
+	if x, ok := x.GetMsg().(*OrchestrateRequest_StartOrchestration); ok {
+		return x.StartOrchestration
+	}
+	return nil
+}
+
+func (x *OrchestrateRequest) GetUpdateByteRanges() *OrchestrateRequest_UpdateByteRanges {
+	if x, ok := x.GetMsg().(*OrchestrateRequest_UpdateByteRanges_); ok {
+		return x.UpdateByteRanges
+	}
+	return nil
+}
+
+func (x *OrchestrateRequest) GetConnectedPeers() *OrchestrateRequest_ConnectedPeers {
+	if x, ok := x.GetMsg().(*OrchestrateRequest_ConnectedPeers_); ok {
+		return x.ConnectedPeers
+	}
+	return nil
+}
+
+func (x *OrchestrateRequest) GetUploadFailed() *OrchestrateRequest_UploadFailed {
+	if x, ok := x.GetMsg().(*OrchestrateRequest_UploadFailed_); ok {
+		return x.UploadFailed
+	}
+	return nil
+}
+
+func (x *OrchestrateRequest) GetSetHash() *OrchestrateRequest_SetHash {
+	if x, ok := x.GetMsg().(*OrchestrateRequest_SetHash_); ok {
+		return x.SetHash
+	}
+	return nil
+}
+
+func (x *OrchestrateRequest) GetHaveOpenHandles() *OrchestrateRequest_HaveOpenHandles {
+	if x, ok := x.GetMsg().(*OrchestrateRequest_HaveOpenHandles_); ok {
+		return x.HaveOpenHandles
+	}
+	return nil
+}
+
+type isOrchestrateRequest_Msg interface {
+	isOrchestrateRequest_Msg()
+}
+
+type OrchestrateRequest_StartOrchestration struct {
+	StartOrchestration *OrchestrateRequest_StartOrchestrationRequest `protobuf:"bytes,1,opt,name=start_orchestration,json=startOrchestration,proto3,oneof"`
+}
+
+type OrchestrateRequest_UpdateByteRanges_ struct {
+	UpdateByteRanges *OrchestrateRequest_UpdateByteRanges `protobuf:"bytes,2,opt,name=update_byte_ranges,json=updateByteRanges,proto3,oneof"`
+}
+
+type OrchestrateRequest_ConnectedPeers_ struct {
+	ConnectedPeers *OrchestrateRequest_ConnectedPeers `protobuf:"bytes,3,opt,name=connected_peers,json=connectedPeers,proto3,oneof"`
+}
+
+type OrchestrateRequest_UploadFailed_ struct {
+	UploadFailed *OrchestrateRequest_UploadFailed `protobuf:"bytes,4,opt,name=upload_failed,json=uploadFailed,proto3,oneof"`
+}
+
+type OrchestrateRequest_SetHash_ struct {
+	SetHash *OrchestrateRequest_SetHash `protobuf:"bytes,5,opt,name=set_hash,json=setHash,proto3,oneof"`
+}
+
+type OrchestrateRequest_HaveOpenHandles_ struct {
+	HaveOpenHandles *OrchestrateRequest_HaveOpenHandles `protobuf:"bytes,6,opt,name=have_open_handles,json=haveOpenHandles,proto3,oneof"`
+}
+
+func (*OrchestrateRequest_StartOrchestration) isOrchestrateRequest_Msg() {}
+
+func (*OrchestrateRequest_UpdateByteRanges_) isOrchestrateRequest_Msg() {}
+
+func (*OrchestrateRequest_ConnectedPeers_) isOrchestrateRequest_Msg() {}
+
+func (*OrchestrateRequest_UploadFailed_) isOrchestrateRequest_Msg() {}
+
+func (*OrchestrateRequest_SetHash_) isOrchestrateRequest_Msg() {}
+
+func (*OrchestrateRequest_HaveOpenHandles_) isOrchestrateRequest_Msg() {}
+
+type OrchestrateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Msg:
+	//	*OrchestrateResponse_Welcome_
+	//	*OrchestrateResponse_PeerList_
+	//	*OrchestrateResponse_UploadCommand_
+	Msg isOrchestrateResponse_Msg `protobuf_oneof:"msg"`
+}
+
+func (x *OrchestrateResponse) Reset() {
+	*x = OrchestrateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrchestrateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrchestrateResponse) ProtoMessage() {}
+
+func (x *OrchestrateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrchestrateResponse.ProtoReflect.Descriptor instead.
+func (*OrchestrateResponse) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{17}
+}
+
+func (m *OrchestrateResponse) GetMsg() isOrchestrateResponse_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (x *OrchestrateResponse) GetWelcome() *OrchestrateResponse_Welcome {
+	if x, ok := x.GetMsg().(*OrchestrateResponse_Welcome_); ok {
+		return x.Welcome
+	}
+	return nil
+}
+
+func (x *OrchestrateResponse) GetPeerList() *OrchestrateResponse_PeerList {
+	if x, ok := x.GetMsg().(*OrchestrateResponse_PeerList_); ok {
+		return x.PeerList
+	}
+	return nil
+}
+
+func (x *OrchestrateResponse) GetUploadCommand() *OrchestrateResponse_UploadCommand {
+	if x, ok := x.GetMsg().(*OrchestrateResponse_UploadCommand_); ok {
+		return x.UploadCommand
+	}
+	return nil
+}
+
+type isOrchestrateResponse_Msg interface {
+	isOrchestrateResponse_Msg()
+}
+
+type OrchestrateResponse_Welcome_ struct {
+	Welcome *OrchestrateResponse_Welcome `protobuf:"bytes,1,opt,name=welcome,proto3,oneof"`
+}
+
+type OrchestrateResponse_PeerList_ struct {
+	PeerList *OrchestrateResponse_PeerList `protobuf:"bytes,2,opt,name=peer_list,json=peerList,proto3,oneof"`
+}
+
+type OrchestrateResponse_UploadCommand_ struct {
+	UploadCommand *OrchestrateResponse_UploadCommand `protobuf:"bytes,3,opt,name=upload_command,json=uploadCommand,proto3,oneof"`
+}
+
+func (*OrchestrateResponse_Welcome_) isOrchestrateResponse_Msg() {}
+
+func (*OrchestrateResponse_PeerList_) isOrchestrateResponse_Msg() {}
+
+func (*OrchestrateResponse_UploadCommand_) isOrchestrateResponse_Msg() {}
+
+type Range struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *Range) Reset() {
+	*x = Range{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Range) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Range) ProtoMessage() {}
+
+func (x *Range) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Range.ProtoReflect.Descriptor instead.
+func (*Range) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Range) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Range) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type PushMetricsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metrics []*PushMetricsRequest_Metric `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+}
+
+func (x *PushMetricsRequest) Reset() {
+	*x = PushMetricsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushMetricsRequest) ProtoMessage() {}
+
+func (x *PushMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushMetricsRequest.ProtoReflect.Descriptor instead.
+func (*PushMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PushMetricsRequest) GetMetrics() []*PushMetricsRequest_Metric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+type PushMetricsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PushMetricsResponse) Reset() {
+	*x = PushMetricsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushMetricsResponse) ProtoMessage() {}
+
+func (x *PushMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushMetricsResponse.ProtoReflect.Descriptor instead.
+func (*PushMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{20}
+}
+
+type PushLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages [][]byte `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *PushLogsRequest) Reset() {
+	*x = PushLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushLogsRequest) ProtoMessage() {}
+
+func (x *PushLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushLogsRequest.ProtoReflect.Descriptor instead.
+func (*PushLogsRequest) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PushLogsRequest) GetMessages() [][]byte {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type PushLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StopSendingLogs bool `protobuf:"varint,1,opt,name=stop_sending_logs,json=stopSendingLogs,proto3" json:"stop_sending_logs,omitempty"`
+}
+
+func (x *PushLogsResponse) Reset() {
+	*x = PushLogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushLogsResponse) ProtoMessage() {}
+
+func (x *PushLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushLogsResponse.ProtoReflect.Descriptor instead.
+func (*PushLogsResponse) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PushLogsResponse) GetStopSendingLogs() bool {
+	if x != nil {
+		return x.StopSendingLogs
+	}
+	return false
+}
+
+type ListClientsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListClientsRequest) Reset() {
+	*x = ListClientsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientsRequest) ProtoMessage() {}
+
+func (x *ListClientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientsRequest.ProtoReflect.Descriptor instead.
+func (*ListClientsRequest) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{23}
+}
+
+type ListClientsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clients []*ListClientsResponse_Client `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
+}
+
+func (x *ListClientsResponse) Reset() {
+	*x = ListClientsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClientsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientsResponse) ProtoMessage() {}
+
+func (x *ListClientsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientsResponse.ProtoReflect.Descriptor instead.
+func (*ListClientsResponse) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListClientsResponse) GetClients() []*ListClientsResponse_Client {
+	if x != nil {
+		return x.Clients
+	}
+	return nil
+}
+
+type ListOrchestrationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListOrchestrationsRequest) Reset() {
+	*x = ListOrchestrationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrchestrationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrchestrationsRequest) ProtoMessage() {}
+
+func (x *ListOrchestrationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrchestrationsRequest.ProtoReflect.Descriptor instead.
+func (*ListOrchestrationsRequest) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{25}
+}
+
+type ListOrchestrationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orchestrations []*ListOrchestrationsResponse_Orchestration `protobuf:"bytes,1,rep,name=orchestrations,proto3" json:"orchestrations,omitempty"`
+}
+
+func (x *ListOrchestrationsResponse) Reset() {
+	*x = ListOrchestrationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrchestrationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrchestrationsResponse) ProtoMessage() {}
+
+func (x *ListOrchestrationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrchestrationsResponse.ProtoReflect.Descriptor instead.
+func (*ListOrchestrationsResponse) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListOrchestrationsResponse) GetOrchestrations() []*ListOrchestrationsResponse_Orchestration {
+	if x != nil {
+		return x.Orchestrations
+	}
+	return nil
+}
+
+type KickClientRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *KickClientRequest) Reset() {
+	*x = KickClientRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickClientRequest) ProtoMessage() {}
+
+func (x *KickClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickClientRequest.ProtoReflect.Descriptor instead.
+func (*KickClientRequest) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *KickClientRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type KickClientResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *KickClientResponse) Reset() {
+	*x = KickClientResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickClientResponse) ProtoMessage() {}
+
+func (x *KickClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickClientResponse.ProtoReflect.Descriptor instead.
+func (*KickClientResponse) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{28}
+}
+
+type EndOrchestrationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DownloadId int64 `protobuf:"varint,1,opt,name=download_id,json=downloadId,proto3" json:"download_id,omitempty"`
+}
+
+func (x *EndOrchestrationRequest) Reset() {
+	*x = EndOrchestrationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndOrchestrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndOrchestrationRequest) ProtoMessage() {}
+
+func (x *EndOrchestrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndOrchestrationRequest.ProtoReflect.Descriptor instead.
+func (*EndOrchestrationRequest) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *EndOrchestrationRequest) GetDownloadId() int64 {
+	if x != nil {
+		return x.DownloadId
+	}
+	return 0
+}
+
+type EndOrchestrationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EndOrchestrationResponse) Reset() {
+	*x = EndOrchestrationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndOrchestrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndOrchestrationResponse) ProtoMessage() {}
+
+func (x *EndOrchestrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndOrchestrationResponse.ProtoReflect.Descriptor instead.
+func (*EndOrchestrationResponse) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{30}
+}
+
+// Group is a named set of users (user@circle, which includes all their
+// devices) and devices (user+device@circle).
+type Group struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *Group) Reset() {
+	*x = Group{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Group) ProtoMessage() {}
+
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Group) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Group) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type ListGroupsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListGroupsRequest) Reset() {
+	*x = ListGroupsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsRequest) ProtoMessage() {}
+
+func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{32}
+}
+
+type ListGroupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *ListGroupsResponse) Reset() {
+	*x = ListGroupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsResponse) ProtoMessage() {}
+
+func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListGroupsResponse) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type SetGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group *Group `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *SetGroupRequest) Reset() {
+	*x = SetGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupRequest) ProtoMessage() {}
+
+func (x *SetGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupRequest.ProtoReflect.Descriptor instead.
+func (*SetGroupRequest) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetGroupRequest) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type SetGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetGroupResponse) Reset() {
+	*x = SetGroupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupResponse) ProtoMessage() {}
+
+func (x *SetGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupResponse.ProtoReflect.Descriptor instead.
+func (*SetGroupResponse) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{35}
+}
+
+type RelayListenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RelayListenRequest) Reset() {
+	*x = RelayListenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelayListenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelayListenRequest) ProtoMessage() {}
+
+func (x *RelayListenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelayListenRequest.ProtoReflect.Descriptor instead.
+func (*RelayListenRequest) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{36}
+}
+
+type RelayListenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectionId int64  `protobuf:"varint,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Peer         string `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+}
+
+func (x *RelayListenResponse) Reset() {
+	*x = RelayListenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelayListenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelayListenResponse) ProtoMessage() {}
+
+func (x *RelayListenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelayListenResponse.ProtoReflect.Descriptor instead.
+func (*RelayListenResponse) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RelayListenResponse) GetConnectionId() int64 {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return 0
+}
+
+func (x *RelayListenResponse) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+type RelayData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DialPeer           string `protobuf:"bytes,1,opt,name=dial_peer,json=dialPeer,proto3" json:"dial_peer,omitempty"`
+	AcceptConnectionId int64  `protobuf:"varint,2,opt,name=accept_connection_id,json=acceptConnectionId,proto3" json:"accept_connection_id,omitempty"`
+	Data               []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Sent by the relay when the other side went away. The receiver should close its stream.
+	Hangup bool `protobuf:"varint,4,opt,name=hangup,proto3" json:"hangup,omitempty"`
+}
+
+func (x *RelayData) Reset() {
+	*x = RelayData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelayData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelayData) ProtoMessage() {}
+
+func (x *RelayData) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelayData.ProtoReflect.Descriptor instead.
+func (*RelayData) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RelayData) GetDialPeer() string {
+	if x != nil {
+		return x.DialPeer
+	}
+	return ""
+}
+
+func (x *RelayData) GetAcceptConnectionId() int64 {
+	if x != nil {
+		return x.AcceptConnectionId
+	}
+	return 0
+}
+
+func (x *RelayData) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RelayData) GetHangup() bool {
+	if x != nil {
+		return x.Hangup
+	}
+	return false
+}
+
+type ReadDirRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *ReadDirRequest) Reset() {
+	*x = ReadDirRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadDirRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDirRequest) ProtoMessage() {}
+
+func (x *ReadDirRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDirRequest.ProtoReflect.Descriptor instead.
+func (*ReadDirRequest) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReadDirRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type ReadDirResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Files []*File `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *ReadDirResponse) Reset() {
+	*x = ReadDirResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadDirResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDirResponse) ProtoMessage() {}
+
+func (x *ReadDirResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDirResponse.ProtoReflect.Descriptor instead.
+func (*ReadDirResponse) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReadDirResponse) GetFiles() []*File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type File struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Optional
+	Hash        string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	IsDirectory bool   `protobuf:"varint,3,opt,name=is_directory,json=isDirectory,proto3" json:"is_directory,omitempty"`
+	Size        int64  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Mtime       int64  `protobuf:"varint,5,opt,name=mtime,proto3" json:"mtime,omitempty"` // UNIX timestamp
+	// Set if the file is a symbolic link the sharer exposes as such. It is relative to the directory of the link, or
+	// absolute.
+	SymlinkTarget string `protobuf:"bytes,6,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlink_target,omitempty"`
+	// Nanoseconds to add to mtime.
+	MtimeNanos int32 `protobuf:"varint,7,opt,name=mtime_nanos,json=mtimeNanos,proto3" json:"mtime_nanos,omitempty"`
+	// Creation time as a UNIX timestamp, if known.
+	Birthtime      int64 `protobuf:"varint,8,opt,name=birthtime,proto3" json:"birthtime,omitempty"`
+	BirthtimeNanos int32 `protobuf:"varint,9,opt,name=birthtime_nanos,json=birthtimeNanos,proto3" json:"birthtime_nanos,omitempty"`
+	// Permission bits (like 0755), or 0 if the sharer doesn't have them (e.g. on Windows).
+	Mode       uint32 `protobuf:"varint,10,opt,name=mode,proto3" json:"mode,omitempty"`
+	Executable bool   `protobuf:"varint,11,opt,name=executable,proto3" json:"executable,omitempty"`
+	// Extended attributes, if the share exposes them.
+	Xattrs map[string][]byte `protobuf:"bytes,12,rep,name=xattrs,proto3" json:"xattrs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *File) Reset() {
+	*x = File{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*File) ProtoMessage() {}
+
+func (x *File) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use File.ProtoReflect.Descriptor instead.
+func (*File) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *File) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *File) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *File) GetIsDirectory() bool {
+	if x != nil {
+		return x.IsDirectory
+	}
+	return false
+}
+
+func (x *File) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *File) GetMtime() int64 {
+	if x != nil {
+		return x.Mtime
+	}
+	return 0
+}
+
+func (x *File) GetSymlinkTarget() string {
+	if x != nil {
+		return x.SymlinkTarget
+	}
+	return ""
+}
+
+func (x *File) GetMtimeNanos() int32 {
+	if x != nil {
+		return x.MtimeNanos
+	}
+	return 0
+}
+
+func (x *File) GetBirthtime() int64 {
+	if x != nil {
+		return x.Birthtime
+	}
+	return 0
+}
+
+func (x *File) GetBirthtimeNanos() int32 {
+	if x != nil {
+		return x.BirthtimeNanos
+	}
+	return 0
+}
+
+func (x *File) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *File) GetExecutable() bool {
+	if x != nil {
+		return x.Executable
+	}
+	return false
+}
+
+func (x *File) GetXattrs() map[string][]byte {
+	if x != nil {
+		return x.Xattrs
+	}
+	return nil
+}
+
+type ReadFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Offset   int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Rdnow    int64  `protobuf:"varint,3,opt,name=rdnow,proto3" json:"rdnow,omitempty"`
+	Rdahead  int64  `protobuf:"varint,4,opt,name=rdahead,proto3" json:"rdahead,omitempty"`
+	// The compression algorithms the caller can decompress.
+	AcceptCompression []Compression `protobuf:"varint,5,rep,packed,name=accept_compression,json=acceptCompression,proto3,enum=Compression" json:"accept_compression,omitempty"`
+}
+
+func (x *ReadFileRequest) Reset() {
+	*x = ReadFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadFileRequest) ProtoMessage() {}
+
+func (x *ReadFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadFileRequest.ProtoReflect.Descriptor instead.
+func (*ReadFileRequest) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReadFileRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ReadFileRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ReadFileRequest) GetRdnow() int64 {
+	if x != nil {
+		return x.Rdnow
+	}
+	return 0
+}
+
+func (x *ReadFileRequest) GetRdahead() int64 {
+	if x != nil {
+		return x.Rdahead
+	}
+	return 0
+}
+
+func (x *ReadFileRequest) GetAcceptCompression() []Compression {
+	if x != nil {
+		return x.AcceptCompression
 	}
 	return nil
 }
 
-type PushMetricsResponse struct {
+type ReadFileResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Offset int64  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data   []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// How data is compressed. Chunks that don't get smaller are sent uncompressed.
+	Compression                    Compression `protobuf:"varint,3,opt,name=compression,proto3,enum=Compression" json:"compression,omitempty"`
+	RedirectToOrchestratedDownload int64       `protobuf:"varint,6,opt,name=redirect_to_orchestrated_download,json=redirectToOrchestratedDownload,proto3" json:"redirect_to_orchestrated_download,omitempty"`
 }
 
-func (x *PushMetricsResponse) Reset() {
-	*x = PushMetricsResponse{}
+func (x *ReadFileResponse) Reset() {
+	*x = ReadFileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[14]
+		mi := &file_rufs_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PushMetricsResponse) String() string {
+func (x *ReadFileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushMetricsResponse) ProtoMessage() {}
+func (*ReadFileResponse) ProtoMessage() {}
 
-func (x *PushMetricsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[14]
+func (x *ReadFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1153,83 +2909,70 @@ func (x *PushMetricsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushMetricsResponse.ProtoReflect.Descriptor instead.
-func (*PushMetricsResponse) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{14}
-}
-
-type PushLogsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Messages [][]byte `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+// Deprecated: Use ReadFileResponse.ProtoReflect.Descriptor instead.
+func (*ReadFileResponse) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *PushLogsRequest) Reset() {
-	*x = PushLogsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ReadFileResponse) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
 	}
+	return 0
 }
 
-func (x *PushLogsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PushLogsRequest) ProtoMessage() {}
-
-func (x *PushLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ReadFileResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use PushLogsRequest.ProtoReflect.Descriptor instead.
-func (*PushLogsRequest) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{15}
+func (x *ReadFileResponse) GetCompression() Compression {
+	if x != nil {
+		return x.Compression
+	}
+	return Compression_UNCOMPRESSED
 }
 
-func (x *PushLogsRequest) GetMessages() [][]byte {
+func (x *ReadFileResponse) GetRedirectToOrchestratedDownload() int64 {
 	if x != nil {
-		return x.Messages
+		return x.RedirectToOrchestratedDownload
 	}
-	return nil
+	return 0
 }
 
-type PushLogsResponse struct {
+type PassiveTransferData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StopSendingLogs bool `protobuf:"varint,1,opt,name=stop_sending_logs,json=stopSendingLogs,proto3" json:"stop_sending_logs,omitempty"`
+	DownloadId  int64       `protobuf:"varint,1,opt,name=download_id,json=downloadId,proto3" json:"download_id,omitempty"`
+	Offset      int64       `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data        []byte      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Compression Compression `protobuf:"varint,4,opt,name=compression,proto3,enum=Compression" json:"compression,omitempty"`
+	// The compression algorithms the sender can decompress. Sent by the caller along with download_id, and in a
+	// message without data by the callee if the caller sent it.
+	AcceptCompression []Compression `protobuf:"varint,5,rep,packed,name=accept_compression,json=acceptCompression,proto3,enum=Compression" json:"accept_compression,omitempty"`
 }
 
-func (x *PushLogsResponse) Reset() {
-	*x = PushLogsResponse{}
+func (x *PassiveTransferData) Reset() {
+	*x = PassiveTransferData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[16]
+		mi := &file_rufs_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PushLogsResponse) String() string {
+func (x *PassiveTransferData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushLogsResponse) ProtoMessage() {}
+func (*PassiveTransferData) ProtoMessage() {}
 
-func (x *PushLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[16]
+func (x *PassiveTransferData) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1240,43 +2983,69 @@ func (x *PushLogsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushLogsResponse.ProtoReflect.Descriptor instead.
-func (*PushLogsResponse) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use PassiveTransferData.ProtoReflect.Descriptor instead.
+func (*PassiveTransferData) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *PushLogsResponse) GetStopSendingLogs() bool {
+func (x *PassiveTransferData) GetDownloadId() int64 {
 	if x != nil {
-		return x.StopSendingLogs
+		return x.DownloadId
 	}
-	return false
+	return 0
 }
 
-type ReadDirRequest struct {
+func (x *PassiveTransferData) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *PassiveTransferData) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PassiveTransferData) GetCompression() Compression {
+	if x != nil {
+		return x.Compression
+	}
+	return Compression_UNCOMPRESSED
+}
+
+func (x *PassiveTransferData) GetAcceptCompression() []Compression {
+	if x != nil {
+		return x.AcceptCompression
+	}
+	return nil
+}
+
+type PingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *ReadDirRequest) Reset() {
-	*x = ReadDirRequest{}
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[17]
+		mi := &file_rufs_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadDirRequest) String() string {
+func (x *PingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadDirRequest) ProtoMessage() {}
+func (*PingRequest) ProtoMessage() {}
 
-func (x *ReadDirRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[17]
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1287,43 +3056,34 @@ func (x *ReadDirRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadDirRequest.ProtoReflect.Descriptor instead.
-func (*ReadDirRequest) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *ReadDirRequest) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{45}
 }
 
-type ReadDirResponse struct {
+type PingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Files []*File `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
 }
 
-func (x *ReadDirResponse) Reset() {
-	*x = ReadDirResponse{}
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[18]
+		mi := &file_rufs_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadDirResponse) String() string {
+func (x *PingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadDirResponse) ProtoMessage() {}
+func (*PingResponse) ProtoMessage() {}
 
-func (x *ReadDirResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[18]
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1334,48 +3094,39 @@ func (x *ReadDirResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadDirResponse.ProtoReflect.Descriptor instead.
-func (*ReadDirResponse) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *ReadDirResponse) GetFiles() []*File {
-	if x != nil {
-		return x.Files
-	}
-	return nil
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{46}
 }
 
-type File struct {
+type ConnectResponse_PeerList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
-	// Optional
-	Hash        string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
-	IsDirectory bool   `protobuf:"varint,3,opt,name=is_directory,json=isDirectory,proto3" json:"is_directory,omitempty"`
-	Size        int64  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
-	Mtime       int64  `protobuf:"varint,5,opt,name=mtime,proto3" json:"mtime,omitempty"` // UNIX timestamp
+	Peers []*Peer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	// Version of the peer list. Deltas are relative to this. Zero if the
+	// discovery server doesn't support deltas.
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *File) Reset() {
-	*x = File{}
+func (x *ConnectResponse_PeerList) Reset() {
+	*x = ConnectResponse_PeerList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[19]
+		mi := &file_rufs_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *File) String() string {
+func (x *ConnectResponse_PeerList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*File) ProtoMessage() {}
+func (*ConnectResponse_PeerList) ProtoMessage() {}
 
-func (x *File) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[19]
+func (x *ConnectResponse_PeerList) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1386,74 +3137,57 @@ func (x *File) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use File.ProtoReflect.Descriptor instead.
-func (*File) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *File) GetFilename() string {
-	if x != nil {
-		return x.Filename
-	}
-	return ""
-}
-
-func (x *File) GetHash() string {
-	if x != nil {
-		return x.Hash
-	}
-	return ""
-}
-
-func (x *File) GetIsDirectory() bool {
-	if x != nil {
-		return x.IsDirectory
-	}
-	return false
+// Deprecated: Use ConnectResponse_PeerList.ProtoReflect.Descriptor instead.
+func (*ConnectResponse_PeerList) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{3, 0}
 }
 
-func (x *File) GetSize() int64 {
+func (x *ConnectResponse_PeerList) GetPeers() []*Peer {
 	if x != nil {
-		return x.Size
+		return x.Peers
 	}
-	return 0
+	return nil
 }
 
-func (x *File) GetMtime() int64 {
+func (x *ConnectResponse_PeerList) GetVersion() int64 {
 	if x != nil {
-		return x.Mtime
+		return x.Version
 	}
 	return 0
 }
 
-type ReadFileRequest struct {
+// PeerListDelta describes the changes since the previous PeerList or
+// PeerListDelta. Clients that didn't see previous_version should reconnect
+// to get a full PeerList.
+type ConnectResponse_PeerListDelta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
-	Offset   int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Rdnow    int64  `protobuf:"varint,3,opt,name=rdnow,proto3" json:"rdnow,omitempty"`
-	Rdahead  int64  `protobuf:"varint,4,opt,name=rdahead,proto3" json:"rdahead,omitempty"`
+	PreviousVersion int64 `protobuf:"varint,1,opt,name=previous_version,json=previousVersion,proto3" json:"previous_version,omitempty"`
+	Version         int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Peers that were added or whose endpoints changed.
+	UpdatedPeers []*Peer  `protobuf:"bytes,3,rep,name=updated_peers,json=updatedPeers,proto3" json:"updated_peers,omitempty"`
+	RemovedPeers []string `protobuf:"bytes,4,rep,name=removed_peers,json=removedPeers,proto3" json:"removed_peers,omitempty"`
 }
 
-func (x *ReadFileRequest) Reset() {
-	*x = ReadFileRequest{}
+func (x *ConnectResponse_PeerListDelta) Reset() {
+	*x = ConnectResponse_PeerListDelta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[20]
+		mi := &file_rufs_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadFileRequest) String() string {
+func (x *ConnectResponse_PeerListDelta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadFileRequest) ProtoMessage() {}
+func (*ConnectResponse_PeerListDelta) ProtoMessage() {}
 
-func (x *ReadFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[20]
+func (x *ConnectResponse_PeerListDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1464,66 +3198,66 @@ func (x *ReadFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadFileRequest.ProtoReflect.Descriptor instead.
-func (*ReadFileRequest) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ConnectResponse_PeerListDelta.ProtoReflect.Descriptor instead.
+func (*ConnectResponse_PeerListDelta) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{3, 1}
 }
 
-func (x *ReadFileRequest) GetFilename() string {
+func (x *ConnectResponse_PeerListDelta) GetPreviousVersion() int64 {
 	if x != nil {
-		return x.Filename
+		return x.PreviousVersion
 	}
-	return ""
+	return 0
 }
 
-func (x *ReadFileRequest) GetOffset() int64 {
+func (x *ConnectResponse_PeerListDelta) GetVersion() int64 {
 	if x != nil {
-		return x.Offset
+		return x.Version
 	}
 	return 0
 }
 
-func (x *ReadFileRequest) GetRdnow() int64 {
+func (x *ConnectResponse_PeerListDelta) GetUpdatedPeers() []*Peer {
 	if x != nil {
-		return x.Rdnow
+		return x.UpdatedPeers
 	}
-	return 0
+	return nil
 }
 
-func (x *ReadFileRequest) GetRdahead() int64 {
+func (x *ConnectResponse_PeerListDelta) GetRemovedPeers() []string {
 	if x != nil {
-		return x.Rdahead
+		return x.RemovedPeers
 	}
-	return 0
+	return nil
 }
 
-type ReadFileResponse struct {
+type ConnectResponse_ActiveDownload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Offset                         int64  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	Data                           []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	RedirectToOrchestratedDownload int64  `protobuf:"varint,6,opt,name=redirect_to_orchestrated_download,json=redirectToOrchestratedDownload,proto3" json:"redirect_to_orchestrated_download,omitempty"`
+	DownloadId int64    `protobuf:"varint,1,opt,name=download_id,json=downloadId,proto3" json:"download_id,omitempty"`
+	Hash       string   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Filenames  []string `protobuf:"bytes,3,rep,name=filenames,proto3" json:"filenames,omitempty"`
 }
 
-func (x *ReadFileResponse) Reset() {
-	*x = ReadFileResponse{}
+func (x *ConnectResponse_ActiveDownload) Reset() {
+	*x = ConnectResponse_ActiveDownload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[21]
+		mi := &file_rufs_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadFileResponse) String() string {
+func (x *ConnectResponse_ActiveDownload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadFileResponse) ProtoMessage() {}
+func (*ConnectResponse_ActiveDownload) ProtoMessage() {}
 
-func (x *ReadFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[21]
+func (x *ConnectResponse_ActiveDownload) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1534,59 +3268,57 @@ func (x *ReadFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadFileResponse.ProtoReflect.Descriptor instead.
-func (*ReadFileResponse) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ConnectResponse_ActiveDownload.ProtoReflect.Descriptor instead.
+func (*ConnectResponse_ActiveDownload) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{3, 2}
 }
 
-func (x *ReadFileResponse) GetOffset() int64 {
+func (x *ConnectResponse_ActiveDownload) GetDownloadId() int64 {
 	if x != nil {
-		return x.Offset
+		return x.DownloadId
 	}
 	return 0
 }
 
-func (x *ReadFileResponse) GetData() []byte {
+func (x *ConnectResponse_ActiveDownload) GetHash() string {
 	if x != nil {
-		return x.Data
+		return x.Hash
 	}
-	return nil
+	return ""
 }
 
-func (x *ReadFileResponse) GetRedirectToOrchestratedDownload() int64 {
+func (x *ConnectResponse_ActiveDownload) GetFilenames() []string {
 	if x != nil {
-		return x.RedirectToOrchestratedDownload
+		return x.Filenames
 	}
-	return 0
+	return nil
 }
 
-type PassiveTransferData struct {
+type ConnectResponse_ActiveDownloadList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DownloadId int64  `protobuf:"varint,1,opt,name=download_id,json=downloadId,proto3" json:"download_id,omitempty"`
-	Offset     int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Data       []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	ActiveDownloads []*ConnectResponse_ActiveDownload `protobuf:"bytes,1,rep,name=active_downloads,json=activeDownloads,proto3" json:"active_downloads,omitempty"`
 }
 
-func (x *PassiveTransferData) Reset() {
-	*x = PassiveTransferData{}
+func (x *ConnectResponse_ActiveDownloadList) Reset() {
+	*x = ConnectResponse_ActiveDownloadList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[22]
+		mi := &file_rufs_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PassiveTransferData) String() string {
+func (x *ConnectResponse_ActiveDownloadList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PassiveTransferData) ProtoMessage() {}
+func (*ConnectResponse_ActiveDownloadList) ProtoMessage() {}
 
-func (x *PassiveTransferData) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[22]
+func (x *ConnectResponse_ActiveDownloadList) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1597,57 +3329,45 @@ func (x *PassiveTransferData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PassiveTransferData.ProtoReflect.Descriptor instead.
-func (*PassiveTransferData) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *PassiveTransferData) GetDownloadId() int64 {
-	if x != nil {
-		return x.DownloadId
-	}
-	return 0
-}
-
-func (x *PassiveTransferData) GetOffset() int64 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
+// Deprecated: Use ConnectResponse_ActiveDownloadList.ProtoReflect.Descriptor instead.
+func (*ConnectResponse_ActiveDownloadList) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{3, 3}
 }
 
-func (x *PassiveTransferData) GetData() []byte {
+func (x *ConnectResponse_ActiveDownloadList) GetActiveDownloads() []*ConnectResponse_ActiveDownload {
 	if x != nil {
-		return x.Data
+		return x.ActiveDownloads
 	}
 	return nil
 }
 
-type ConnectResponse_PeerList struct {
+// DiscoveryAddresses are the addresses (host:port) the discovery server
+// can be reached on. Clients should use them for their next connection.
+type ConnectResponse_DiscoveryAddresses struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Peers []*Peer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
 
-func (x *ConnectResponse_PeerList) Reset() {
-	*x = ConnectResponse_PeerList{}
+func (x *ConnectResponse_DiscoveryAddresses) Reset() {
+	*x = ConnectResponse_DiscoveryAddresses{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[23]
+		mi := &file_rufs_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConnectResponse_PeerList) String() string {
+func (x *ConnectResponse_DiscoveryAddresses) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectResponse_PeerList) ProtoMessage() {}
+func (*ConnectResponse_DiscoveryAddresses) ProtoMessage() {}
 
-func (x *ConnectResponse_PeerList) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[23]
+func (x *ConnectResponse_DiscoveryAddresses) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1658,45 +3378,45 @@ func (x *ConnectResponse_PeerList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectResponse_PeerList.ProtoReflect.Descriptor instead.
-func (*ConnectResponse_PeerList) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use ConnectResponse_DiscoveryAddresses.ProtoReflect.Descriptor instead.
+func (*ConnectResponse_DiscoveryAddresses) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{3, 4}
 }
 
-func (x *ConnectResponse_PeerList) GetPeers() []*Peer {
+func (x *ConnectResponse_DiscoveryAddresses) GetAddresses() []string {
 	if x != nil {
-		return x.Peers
+		return x.Addresses
 	}
 	return nil
 }
 
-type ConnectResponse_ActiveDownload struct {
+// GroupList contains all groups of the circle. It is sent when the
+// client connects and whenever a group changes.
+type ConnectResponse_GroupList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DownloadId int64    `protobuf:"varint,1,opt,name=download_id,json=downloadId,proto3" json:"download_id,omitempty"`
-	Hash       string   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
-	Filenames  []string `protobuf:"bytes,3,rep,name=filenames,proto3" json:"filenames,omitempty"`
+	Groups []*Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
 }
 
-func (x *ConnectResponse_ActiveDownload) Reset() {
-	*x = ConnectResponse_ActiveDownload{}
+func (x *ConnectResponse_GroupList) Reset() {
+	*x = ConnectResponse_GroupList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[24]
+		mi := &file_rufs_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConnectResponse_ActiveDownload) String() string {
+func (x *ConnectResponse_GroupList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectResponse_ActiveDownload) ProtoMessage() {}
+func (*ConnectResponse_GroupList) ProtoMessage() {}
 
-func (x *ConnectResponse_ActiveDownload) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[24]
+func (x *ConnectResponse_GroupList) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1707,57 +3427,46 @@ func (x *ConnectResponse_ActiveDownload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectResponse_ActiveDownload.ProtoReflect.Descriptor instead.
-func (*ConnectResponse_ActiveDownload) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{3, 1}
-}
-
-func (x *ConnectResponse_ActiveDownload) GetDownloadId() int64 {
-	if x != nil {
-		return x.DownloadId
-	}
-	return 0
-}
-
-func (x *ConnectResponse_ActiveDownload) GetHash() string {
-	if x != nil {
-		return x.Hash
-	}
-	return ""
+// Deprecated: Use ConnectResponse_GroupList.ProtoReflect.Descriptor instead.
+func (*ConnectResponse_GroupList) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{3, 5}
 }
 
-func (x *ConnectResponse_ActiveDownload) GetFilenames() []string {
+func (x *ConnectResponse_GroupList) GetGroups() []*Group {
 	if x != nil {
-		return x.Filenames
+		return x.Groups
 	}
 	return nil
 }
 
-type ConnectResponse_ActiveDownloadList struct {
+type LanAnnouncement_Payload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ActiveDownloads []*ConnectResponse_ActiveDownload `protobuf:"bytes,1,rep,name=active_downloads,json=activeDownloads,proto3" json:"active_downloads,omitempty"`
+	Circle    string      `protobuf:"bytes,1,opt,name=circle,proto3" json:"circle,omitempty"`
+	Peer      string      `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	Endpoints []*Endpoint `protobuf:"bytes,3,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	Timestamp int64       `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // UNIX timestamp
 }
 
-func (x *ConnectResponse_ActiveDownloadList) Reset() {
-	*x = ConnectResponse_ActiveDownloadList{}
+func (x *LanAnnouncement_Payload) Reset() {
+	*x = LanAnnouncement_Payload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[25]
+		mi := &file_rufs_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConnectResponse_ActiveDownloadList) String() string {
+func (x *LanAnnouncement_Payload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectResponse_ActiveDownloadList) ProtoMessage() {}
+func (*LanAnnouncement_Payload) ProtoMessage() {}
 
-func (x *ConnectResponse_ActiveDownloadList) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[25]
+func (x *LanAnnouncement_Payload) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1768,18 +3477,39 @@ func (x *ConnectResponse_ActiveDownloadList) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectResponse_ActiveDownloadList.ProtoReflect.Descriptor instead.
-func (*ConnectResponse_ActiveDownloadList) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{3, 2}
+// Deprecated: Use LanAnnouncement_Payload.ProtoReflect.Descriptor instead.
+func (*LanAnnouncement_Payload) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{13, 0}
 }
 
-func (x *ConnectResponse_ActiveDownloadList) GetActiveDownloads() []*ConnectResponse_ActiveDownload {
+func (x *LanAnnouncement_Payload) GetCircle() string {
 	if x != nil {
-		return x.ActiveDownloads
+		return x.Circle
+	}
+	return ""
+}
+
+func (x *LanAnnouncement_Payload) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *LanAnnouncement_Payload) GetEndpoints() []*Endpoint {
+	if x != nil {
+		return x.Endpoints
 	}
 	return nil
 }
 
+func (x *LanAnnouncement_Payload) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
 type OrchestrateRequest_StartOrchestrationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1793,7 +3523,7 @@ type OrchestrateRequest_StartOrchestrationRequest struct {
 func (x *OrchestrateRequest_StartOrchestrationRequest) Reset() {
 	*x = OrchestrateRequest_StartOrchestrationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[26]
+		mi := &file_rufs_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1806,7 +3536,7 @@ func (x *OrchestrateRequest_StartOrchestrationRequest) String() string {
 func (*OrchestrateRequest_StartOrchestrationRequest) ProtoMessage() {}
 
 func (x *OrchestrateRequest_StartOrchestrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[26]
+	mi := &file_rufs_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1819,7 +3549,7 @@ func (x *OrchestrateRequest_StartOrchestrationRequest) ProtoReflect() protorefle
 
 // Deprecated: Use OrchestrateRequest_StartOrchestrationRequest.ProtoReflect.Descriptor instead.
 func (*OrchestrateRequest_StartOrchestrationRequest) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{10, 0}
+	return file_rufs_proto_rawDescGZIP(), []int{16, 0}
 }
 
 func (x *OrchestrateRequest_StartOrchestrationRequest) GetDownloadId() int64 {
@@ -1856,7 +3586,7 @@ type OrchestrateRequest_UpdateByteRanges struct {
 func (x *OrchestrateRequest_UpdateByteRanges) Reset() {
 	*x = OrchestrateRequest_UpdateByteRanges{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[27]
+		mi := &file_rufs_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1869,7 +3599,7 @@ func (x *OrchestrateRequest_UpdateByteRanges) String() string {
 func (*OrchestrateRequest_UpdateByteRanges) ProtoMessage() {}
 
 func (x *OrchestrateRequest_UpdateByteRanges) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[27]
+	mi := &file_rufs_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1882,7 +3612,7 @@ func (x *OrchestrateRequest_UpdateByteRanges) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use OrchestrateRequest_UpdateByteRanges.ProtoReflect.Descriptor instead.
 func (*OrchestrateRequest_UpdateByteRanges) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{10, 1}
+	return file_rufs_proto_rawDescGZIP(), []int{16, 1}
 }
 
 func (x *OrchestrateRequest_UpdateByteRanges) GetHave() []*Range {
@@ -1917,7 +3647,7 @@ type OrchestrateRequest_ConnectedPeers struct {
 func (x *OrchestrateRequest_ConnectedPeers) Reset() {
 	*x = OrchestrateRequest_ConnectedPeers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[28]
+		mi := &file_rufs_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1930,7 +3660,7 @@ func (x *OrchestrateRequest_ConnectedPeers) String() string {
 func (*OrchestrateRequest_ConnectedPeers) ProtoMessage() {}
 
 func (x *OrchestrateRequest_ConnectedPeers) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[28]
+	mi := &file_rufs_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1943,7 +3673,7 @@ func (x *OrchestrateRequest_ConnectedPeers) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use OrchestrateRequest_ConnectedPeers.ProtoReflect.Descriptor instead.
 func (*OrchestrateRequest_ConnectedPeers) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{10, 2}
+	return file_rufs_proto_rawDescGZIP(), []int{16, 2}
 }
 
 func (x *OrchestrateRequest_ConnectedPeers) GetPeers() []string {
@@ -1964,7 +3694,7 @@ type OrchestrateRequest_UploadFailed struct {
 func (x *OrchestrateRequest_UploadFailed) Reset() {
 	*x = OrchestrateRequest_UploadFailed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[29]
+		mi := &file_rufs_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1977,7 +3707,7 @@ func (x *OrchestrateRequest_UploadFailed) String() string {
 func (*OrchestrateRequest_UploadFailed) ProtoMessage() {}
 
 func (x *OrchestrateRequest_UploadFailed) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[29]
+	mi := &file_rufs_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1990,7 +3720,7 @@ func (x *OrchestrateRequest_UploadFailed) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrchestrateRequest_UploadFailed.ProtoReflect.Descriptor instead.
 func (*OrchestrateRequest_UploadFailed) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{10, 3}
+	return file_rufs_proto_rawDescGZIP(), []int{16, 3}
 }
 
 func (x *OrchestrateRequest_UploadFailed) GetTargetPeers() []string {
@@ -2011,7 +3741,7 @@ type OrchestrateRequest_SetHash struct {
 func (x *OrchestrateRequest_SetHash) Reset() {
 	*x = OrchestrateRequest_SetHash{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[30]
+		mi := &file_rufs_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2024,7 +3754,7 @@ func (x *OrchestrateRequest_SetHash) String() string {
 func (*OrchestrateRequest_SetHash) ProtoMessage() {}
 
 func (x *OrchestrateRequest_SetHash) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[30]
+	mi := &file_rufs_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2037,7 +3767,7 @@ func (x *OrchestrateRequest_SetHash) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrchestrateRequest_SetHash.ProtoReflect.Descriptor instead.
 func (*OrchestrateRequest_SetHash) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{10, 4}
+	return file_rufs_proto_rawDescGZIP(), []int{16, 4}
 }
 
 func (x *OrchestrateRequest_SetHash) GetHash() string {
@@ -2058,7 +3788,7 @@ type OrchestrateRequest_HaveOpenHandles struct {
 func (x *OrchestrateRequest_HaveOpenHandles) Reset() {
 	*x = OrchestrateRequest_HaveOpenHandles{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[31]
+		mi := &file_rufs_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2071,7 +3801,7 @@ func (x *OrchestrateRequest_HaveOpenHandles) String() string {
 func (*OrchestrateRequest_HaveOpenHandles) ProtoMessage() {}
 
 func (x *OrchestrateRequest_HaveOpenHandles) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[31]
+	mi := &file_rufs_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2084,7 +3814,7 @@ func (x *OrchestrateRequest_HaveOpenHandles) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use OrchestrateRequest_HaveOpenHandles.ProtoReflect.Descriptor instead.
 func (*OrchestrateRequest_HaveOpenHandles) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{10, 5}
+	return file_rufs_proto_rawDescGZIP(), []int{16, 5}
 }
 
 func (x *OrchestrateRequest_HaveOpenHandles) GetHaveOpenHandles() bool {
@@ -2105,7 +3835,7 @@ type OrchestrateResponse_Welcome struct {
 func (x *OrchestrateResponse_Welcome) Reset() {
 	*x = OrchestrateResponse_Welcome{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[32]
+		mi := &file_rufs_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2118,7 +3848,7 @@ func (x *OrchestrateResponse_Welcome) String() string {
 func (*OrchestrateResponse_Welcome) ProtoMessage() {}
 
 func (x *OrchestrateResponse_Welcome) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[32]
+	mi := &file_rufs_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2131,7 +3861,7 @@ func (x *OrchestrateResponse_Welcome) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrchestrateResponse_Welcome.ProtoReflect.Descriptor instead.
 func (*OrchestrateResponse_Welcome) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{11, 0}
+	return file_rufs_proto_rawDescGZIP(), []int{17, 0}
 }
 
 func (x *OrchestrateResponse_Welcome) GetDownloadId() int64 {
@@ -2152,7 +3882,7 @@ type OrchestrateResponse_PeerList struct {
 func (x *OrchestrateResponse_PeerList) Reset() {
 	*x = OrchestrateResponse_PeerList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[33]
+		mi := &file_rufs_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2162,10 +3892,115 @@ func (x *OrchestrateResponse_PeerList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrchestrateResponse_PeerList) ProtoMessage() {}
+func (*OrchestrateResponse_PeerList) ProtoMessage() {}
+
+func (x *OrchestrateResponse_PeerList) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrchestrateResponse_PeerList.ProtoReflect.Descriptor instead.
+func (*OrchestrateResponse_PeerList) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{17, 1}
+}
+
+func (x *OrchestrateResponse_PeerList) GetPeers() []string {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+type OrchestrateResponse_UploadCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Peer  string `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	Range *Range `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
+}
+
+func (x *OrchestrateResponse_UploadCommand) Reset() {
+	*x = OrchestrateResponse_UploadCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrchestrateResponse_UploadCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrchestrateResponse_UploadCommand) ProtoMessage() {}
+
+func (x *OrchestrateResponse_UploadCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrchestrateResponse_UploadCommand.ProtoReflect.Descriptor instead.
+func (*OrchestrateResponse_UploadCommand) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{17, 2}
+}
+
+func (x *OrchestrateResponse_UploadCommand) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *OrchestrateResponse_UploadCommand) GetRange() *Range {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+type PushMetricsRequest_Metric struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                    PushMetricsRequest_MetricId `protobuf:"varint,1,opt,name=id,proto3,enum=PushMetricsRequest_MetricId" json:"id,omitempty"`
+	Fields                []string                    `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	SingleValue           float64                     `protobuf:"fixed64,3,opt,name=single_value,json=singleValue,proto3" json:"single_value,omitempty"`
+	NewDistributionValues []float64                   `protobuf:"fixed64,4,rep,packed,name=new_distribution_values,json=newDistributionValues,proto3" json:"new_distribution_values,omitempty"`
+}
+
+func (x *PushMetricsRequest_Metric) Reset() {
+	*x = PushMetricsRequest_Metric{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rufs_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushMetricsRequest_Metric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushMetricsRequest_Metric) ProtoMessage() {}
 
-func (x *OrchestrateResponse_PeerList) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[33]
+func (x *PushMetricsRequest_Metric) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2176,44 +4011,68 @@ func (x *OrchestrateResponse_PeerList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrchestrateResponse_PeerList.ProtoReflect.Descriptor instead.
-func (*OrchestrateResponse_PeerList) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{11, 1}
+// Deprecated: Use PushMetricsRequest_Metric.ProtoReflect.Descriptor instead.
+func (*PushMetricsRequest_Metric) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{19, 0}
 }
 
-func (x *OrchestrateResponse_PeerList) GetPeers() []string {
+func (x *PushMetricsRequest_Metric) GetId() PushMetricsRequest_MetricId {
 	if x != nil {
-		return x.Peers
+		return x.Id
+	}
+	return PushMetricsRequest_UNKNOWN
+}
+
+func (x *PushMetricsRequest_Metric) GetFields() []string {
+	if x != nil {
+		return x.Fields
 	}
 	return nil
 }
 
-type OrchestrateResponse_UploadCommand struct {
+func (x *PushMetricsRequest_Metric) GetSingleValue() float64 {
+	if x != nil {
+		return x.SingleValue
+	}
+	return 0
+}
+
+func (x *PushMetricsRequest_Metric) GetNewDistributionValues() []float64 {
+	if x != nil {
+		return x.NewDistributionValues
+	}
+	return nil
+}
+
+type ListClientsResponse_Client struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Peer  string `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
-	Range *Range `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
+	Name           string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ClientVersion  string      `protobuf:"bytes,2,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	Endpoints      []*Endpoint `protobuf:"bytes,3,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	ConnectedSince int64       `protobuf:"varint,4,opt,name=connected_since,json=connectedSince,proto3" json:"connected_since,omitempty"` // UNIX timestamp
+	Address        string      `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *OrchestrateResponse_UploadCommand) Reset() {
-	*x = OrchestrateResponse_UploadCommand{}
+func (x *ListClientsResponse_Client) Reset() {
+	*x = ListClientsResponse_Client{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[34]
+		mi := &file_rufs_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OrchestrateResponse_UploadCommand) String() string {
+func (x *ListClientsResponse_Client) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrchestrateResponse_UploadCommand) ProtoMessage() {}
+func (*ListClientsResponse_Client) ProtoMessage() {}
 
-func (x *OrchestrateResponse_UploadCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[34]
+func (x *ListClientsResponse_Client) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2224,53 +4083,74 @@ func (x *OrchestrateResponse_UploadCommand) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrchestrateResponse_UploadCommand.ProtoReflect.Descriptor instead.
-func (*OrchestrateResponse_UploadCommand) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{11, 2}
+// Deprecated: Use ListClientsResponse_Client.ProtoReflect.Descriptor instead.
+func (*ListClientsResponse_Client) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{24, 0}
 }
 
-func (x *OrchestrateResponse_UploadCommand) GetPeer() string {
+func (x *ListClientsResponse_Client) GetName() string {
 	if x != nil {
-		return x.Peer
+		return x.Name
 	}
 	return ""
 }
 
-func (x *OrchestrateResponse_UploadCommand) GetRange() *Range {
+func (x *ListClientsResponse_Client) GetClientVersion() string {
 	if x != nil {
-		return x.Range
+		return x.ClientVersion
+	}
+	return ""
+}
+
+func (x *ListClientsResponse_Client) GetEndpoints() []*Endpoint {
+	if x != nil {
+		return x.Endpoints
 	}
 	return nil
 }
 
-type PushMetricsRequest_Metric struct {
+func (x *ListClientsResponse_Client) GetConnectedSince() int64 {
+	if x != nil {
+		return x.ConnectedSince
+	}
+	return 0
+}
+
+func (x *ListClientsResponse_Client) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type ListOrchestrationsResponse_Orchestration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                    PushMetricsRequest_MetricId `protobuf:"varint,1,opt,name=id,proto3,enum=PushMetricsRequest_MetricId" json:"id,omitempty"`
-	Fields                []string                    `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
-	SingleValue           float64                     `protobuf:"fixed64,3,opt,name=single_value,json=singleValue,proto3" json:"single_value,omitempty"`
-	NewDistributionValues []float64                   `protobuf:"fixed64,4,rep,packed,name=new_distribution_values,json=newDistributionValues,proto3" json:"new_distribution_values,omitempty"`
+	DownloadId int64    `protobuf:"varint,1,opt,name=download_id,json=downloadId,proto3" json:"download_id,omitempty"`
+	Hash       string   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Filenames  []string `protobuf:"bytes,3,rep,name=filenames,proto3" json:"filenames,omitempty"`
+	Peers      []string `protobuf:"bytes,4,rep,name=peers,proto3" json:"peers,omitempty"`
 }
 
-func (x *PushMetricsRequest_Metric) Reset() {
-	*x = PushMetricsRequest_Metric{}
+func (x *ListOrchestrationsResponse_Orchestration) Reset() {
+	*x = ListOrchestrationsResponse_Orchestration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rufs_proto_msgTypes[35]
+		mi := &file_rufs_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PushMetricsRequest_Metric) String() string {
+func (x *ListOrchestrationsResponse_Orchestration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushMetricsRequest_Metric) ProtoMessage() {}
+func (*ListOrchestrationsResponse_Orchestration) ProtoMessage() {}
 
-func (x *PushMetricsRequest_Metric) ProtoReflect() protoreflect.Message {
-	mi := &file_rufs_proto_msgTypes[35]
+func (x *ListOrchestrationsResponse_Orchestration) ProtoReflect() protoreflect.Message {
+	mi := &file_rufs_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2281,35 +4161,35 @@ func (x *PushMetricsRequest_Metric) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushMetricsRequest_Metric.ProtoReflect.Descriptor instead.
-func (*PushMetricsRequest_Metric) Descriptor() ([]byte, []int) {
-	return file_rufs_proto_rawDescGZIP(), []int{13, 0}
+// Deprecated: Use ListOrchestrationsResponse_Orchestration.ProtoReflect.Descriptor instead.
+func (*ListOrchestrationsResponse_Orchestration) Descriptor() ([]byte, []int) {
+	return file_rufs_proto_rawDescGZIP(), []int{26, 0}
 }
 
-func (x *PushMetricsRequest_Metric) GetId() PushMetricsRequest_MetricId {
+func (x *ListOrchestrationsResponse_Orchestration) GetDownloadId() int64 {
 	if x != nil {
-		return x.Id
+		return x.DownloadId
 	}
-	return PushMetricsRequest_UNKNOWN
+	return 0
 }
 
-func (x *PushMetricsRequest_Metric) GetFields() []string {
+func (x *ListOrchestrationsResponse_Orchestration) GetHash() string {
 	if x != nil {
-		return x.Fields
+		return x.Hash
 	}
-	return nil
+	return ""
 }
 
-func (x *PushMetricsRequest_Metric) GetSingleValue() float64 {
+func (x *ListOrchestrationsResponse_Orchestration) GetFilenames() []string {
 	if x != nil {
-		return x.SingleValue
+		return x.Filenames
 	}
-	return 0
+	return nil
 }
 
-func (x *PushMetricsRequest_Metric) GetNewDistributionValues() []float64 {
+func (x *ListOrchestrationsResponse_Orchestration) GetPeers() []string {
 	if x != nil {
-		return x.NewDistributionValues
+		return x.Peers
 	}
 	return nil
 }
@@ -2356,7 +4236,7 @@ var File_rufs_proto protoreflect.FileDescriptor
 var file_rufs_proto_rawDesc = []byte{
 	0x0a, 0x0a, 0x72, 0x75, 0x66, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x89,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa1,
 	0x01, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
@@ -2365,386 +4245,635 @@ var file_rufs_proto_rawDesc = []byte{
 	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
 	0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65,
 	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x10, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x22, 0x85, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x6c, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x6c, 0x64, 0x45,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x27, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x65,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xe9, 0x03, 0x0a, 0x0f, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09,
-	0x70, 0x65, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x70, 0x65,
-	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x50, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x23, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
-	0x64, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44,
-	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x53, 0x0a, 0x18, 0x72, 0x65, 0x73, 0x6f,
-	0x6c, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x16, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x0a,
-	0x08, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x05, 0x70, 0x65, 0x65,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52,
-	0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x1a, 0x63, 0x0a, 0x0e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64,
-	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
-	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a,
-	0x09, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x60, 0x0a, 0x12, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x4a, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x6f, 0x77, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0f, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x42, 0x05, 0x0a,
-	0x03, 0x6d, 0x73, 0x67, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x49, 0x50, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x21, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x49,
-	0x50, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x7e, 0x0a, 0x08, 0x45, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x34, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x07, 0x0a,
-	0x03, 0x54, 0x43, 0x50, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x43, 0x54, 0x50, 0x5f, 0x4f,
-	0x56, 0x45, 0x52, 0x5f, 0x55, 0x44, 0x50, 0x10, 0x02, 0x22, 0x68, 0x0a, 0x04, 0x50, 0x65, 0x65,
-	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x6c, 0x64, 0x5f, 0x65, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x6c,
-	0x64, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x09, 0x65, 0x6e,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e,
-	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x22, 0x34, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x73,
-	0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x07, 0x0a, 0x12, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x60, 0x0a, 0x13, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x4f, 0x72, 0x63, 0x68, 0x65,
-	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x54, 0x0a,
-	0x12, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x61, 0x6e,
-	0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x4f, 0x72, 0x63, 0x68,
-	0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x48,
-	0x00, 0x52, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65, 0x52, 0x61, 0x6e,
-	0x67, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f,
+	0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x22, 0x34, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0xe5, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6f,
+	0x6c, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x6f, 0x6c, 0x64, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x45, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x39, 0x0a, 0x19, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x16, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x50, 0x65, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x73, 0x12, 0x23, 0x0a, 0x08, 0x6e,
+	0x61, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x08, 0x2e,
+	0x4e, 0x41, 0x54, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x6e, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x9a, 0x08, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x50,
+	0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73,
+	0x12, 0x53, 0x0a, 0x18, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x16, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x0f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x48, 0x00,
+	0x52, 0x0d, 0x70, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12,
+	0x34, 0x0a, 0x0d, 0x70, 0x75, 0x6e, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x75, 0x6e, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x48, 0x00, 0x52, 0x12, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x34, 0x0a,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x1a, 0x41, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0xa5, 0x01, 0x0a, 0x0d, 0x50, 0x65, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a,
+	0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x0c, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73, 0x1a, 0x63,
+	0x0a, 0x0e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x1a, 0x60, 0x0a, 0x12, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x10, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x73, 0x1a, 0x32, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x1a, 0x2b, 0x0a, 0x09, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x22, 0xce, 0x01,
+	0x0a, 0x0c, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x70, 0x75, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x70, 0x75, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x27, 0x0a,
+	0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f,
+	0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x65,
+	0x6c, 0x61, 0x79, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x22, 0x29,
+	0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x22, 0x31, 0x0a, 0x14, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x75, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x75, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x22, 0x76, 0x0a, 0x18,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x75, 0x6e, 0x63,
+	0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x75, 0x6e, 0x63,
+	0x68, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x75,
+	0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x49, 0x50, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x21, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x49, 0x50, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0xa2, 0x01, 0x0a, 0x08, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0e, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x58, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x54,
+	0x43, 0x50, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x43, 0x54, 0x50, 0x5f, 0x4f, 0x56, 0x45,
+	0x52, 0x5f, 0x55, 0x44, 0x50, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x4c, 0x41, 0x59,
+	0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x51, 0x55, 0x49, 0x43, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09,
+	0x57, 0x45, 0x42, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x10, 0x05, 0x22, 0x8d, 0x01, 0x0a, 0x04,
+	0x50, 0x65, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x6c, 0x64, 0x5f,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x6f, 0x6c, 0x64, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a,
+	0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x08, 0x6e, 0x61, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x08, 0x2e, 0x4e, 0x41, 0x54, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x07, 0x6e, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xe9, 0x01, 0x0a, 0x0f,
+	0x4c, 0x61, 0x6e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x7c, 0x0a, 0x07, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x65, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72,
+	0x12, 0x27, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x34, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x19, 0x0a,
+	0x17, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x07, 0x0a, 0x12, 0x4f, 0x72, 0x63,
+	0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x60, 0x0a, 0x13, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x4f,
 	0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73,
-	0x48, 0x00, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x65, 0x65,
-	0x72, 0x73, 0x12, 0x47, 0x0a, 0x0d, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x66, 0x61, 0x69,
-	0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x4f, 0x72, 0x63, 0x68,
-	0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x55,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x75,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x08, 0x73,
-	0x65, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x74, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x54, 0x0a, 0x12, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x48, 0x00, 0x52, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50,
+	0x65, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x0d, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
 	0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x48, 0x00, 0x52, 0x07, 0x73, 0x65,
-	0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x51, 0x0a, 0x11, 0x68, 0x61, 0x76, 0x65, 0x5f, 0x6f, 0x70,
-	0x65, 0x6e, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x23, 0x2e, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x48, 0x61, 0x76, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x48, 0x61,
-	0x6e, 0x64, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0f, 0x68, 0x61, 0x76, 0x65, 0x4f, 0x70, 0x65,
-	0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x1a, 0x6c, 0x0a, 0x19, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
-	0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x6f, 0x77, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x1a, 0x76, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x42, 0x79, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x04, 0x68, 0x61,
-	0x76, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65,
-	0x52, 0x04, 0x68, 0x61, 0x76, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x64, 0x6e, 0x6f,
-	0x77, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
-	0x07, 0x72, 0x65, 0x61, 0x64, 0x6e, 0x6f, 0x77, 0x12, 0x24, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64,
-	0x61, 0x68, 0x65, 0x61, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x52, 0x61,
-	0x6e, 0x67, 0x65, 0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x61, 0x68, 0x65, 0x61, 0x64, 0x1a, 0x26,
-	0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x1a, 0x31, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x1a, 0x1d, 0x0a, 0x07, 0x53, 0x65, 0x74,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x1a, 0x3b, 0x0a, 0x0f, 0x48, 0x61, 0x76, 0x65,
-	0x4f, 0x70, 0x65, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x68,
-	0x61, 0x76, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x61, 0x76, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x48, 0x61,
-	0x6e, 0x64, 0x6c, 0x65, 0x73, 0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x22, 0xf2, 0x02, 0x0a,
-	0x13, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x77, 0x65, 0x6c, 0x63, 0x6f, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x57, 0x65, 0x6c, 0x63,
-	0x6f, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x07, 0x77, 0x65, 0x6c, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x3c,
-	0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
-	0x48, 0x00, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x0e,
-	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61,
-	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x75, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x1a, 0x2a, 0x0a, 0x07, 0x57, 0x65, 0x6c,
-	0x63, 0x6f, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
-	0x6f, 0x61, 0x64, 0x49, 0x64, 0x1a, 0x20, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x1a, 0x41, 0x0a, 0x0d, 0x55, 0x70, 0x6c, 0x6f, 0x61,
-	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x05,
-	0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x52, 0x61,
-	0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73,
-	0x67, 0x22, 0x2f, 0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65,
-	0x6e, 0x64, 0x22, 0x86, 0x12, 0x0a, 0x12, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x6d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x50, 0x75, 0x73,
+	0x73, 0x74, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12,
+	0x38, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x48, 0x00,
+	0x52, 0x07, 0x73, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x51, 0x0a, 0x11, 0x68, 0x61, 0x76,
+	0x65, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x48, 0x61, 0x76, 0x65, 0x4f, 0x70,
+	0x65, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0f, 0x68, 0x61, 0x76,
+	0x65, 0x4f, 0x70, 0x65, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x1a, 0x6c, 0x0a, 0x19,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x1a, 0x76, 0x0a, 0x10, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1a,
+	0x0a, 0x04, 0x68, 0x61, 0x76, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x04, 0x68, 0x61, 0x76, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x72, 0x65,
+	0x61, 0x64, 0x6e, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x07, 0x72, 0x65, 0x61, 0x64, 0x6e, 0x6f, 0x77, 0x12, 0x24, 0x0a, 0x09,
+	0x72, 0x65, 0x61, 0x64, 0x61, 0x68, 0x65, 0x61, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x61, 0x68, 0x65,
+	0x61, 0x64, 0x1a, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50,
+	0x65, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x1a, 0x31, 0x0a, 0x0c, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x1a, 0x1d, 0x0a,
+	0x07, 0x53, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x1a, 0x3b, 0x0a, 0x0f,
+	0x48, 0x61, 0x76, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12,
+	0x28, 0x0a, 0x0f, 0x68, 0x61, 0x76, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x61, 0x76, 0x65, 0x4f, 0x70,
+	0x65, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67,
+	0x22, 0xf2, 0x02, 0x0a, 0x13, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x77, 0x65, 0x6c, 0x63,
+	0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4f, 0x72, 0x63, 0x68,
+	0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x57, 0x65, 0x6c, 0x63, 0x6f, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x07, 0x77, 0x65, 0x6c, 0x63, 0x6f,
+	0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x65, 0x65, 0x72,
+	0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x4b, 0x0a, 0x0e, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x72, 0x63, 0x68, 0x65,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0d,
+	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x1a, 0x2a, 0x0a,
+	0x07, 0x57, 0x65, 0x6c, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x1a, 0x20, 0x0a, 0x08, 0x50, 0x65, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x1a, 0x41, 0x0a, 0x0d, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x65, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72,
+	0x12, 0x1c, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x05,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x2f, 0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x86, 0x12, 0x0a, 0x12, 0x50, 0x75, 0x73, 0x68, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a,
+	0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x1a, 0xa9, 0x01, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x2c,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x50, 0x75, 0x73,
 	0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x1a,
-	0xa9, 0x01, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
-	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x6e, 0x65, 0x77, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x01, 0x52, 0x15, 0x6e, 0x65, 0x77, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x0a, 0x4d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b,
-	0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x49,
-	0x4e, 0x54, 0x36, 0x34, 0x5f, 0x47, 0x41, 0x55, 0x47, 0x45, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a,
-	0x54, 0x49, 0x4d, 0x45, 0x5f, 0x47, 0x41, 0x55, 0x47, 0x45, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07,
-	0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x49, 0x53,
-	0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x22, 0xa9, 0x0d, 0x0a, 0x08,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x11, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e,
-	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x1a, 0x04, 0x88, 0xb5, 0x18, 0x00, 0x12, 0x4d, 0x0a, 0x19, 0x43,
-	0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45,
-	0x5f, 0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x53, 0x10, 0x01, 0x1a, 0x2e, 0x88, 0xb5, 0x18, 0x02,
-	0x9a, 0xb5, 0x18, 0x26, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x20, 0x61, 0x74,
-	0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x56, 0x0a, 0x0e, 0x43, 0x4c,
-	0x49, 0x45, 0x4e, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x14, 0x1a, 0x42,
-	0x88, 0xb5, 0x18, 0x01, 0x92, 0xb5, 0x18, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x9a,
-	0xb5, 0x18, 0x2f, 0x41, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x20, 0x31, 0x2c, 0x20, 0x74, 0x68, 0x65,
-	0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x20,
-	0x74, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x15, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x52,
-	0x45, 0x41, 0x44, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x1a, 0x28, 0x88,
-	0xb5, 0x18, 0x01, 0x9a, 0xb5, 0x18, 0x20, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66,
-	0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x20, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x20, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x45, 0x0a, 0x0e, 0x54, 0x52, 0x41, 0x4e, 0x53,
-	0x46, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x53, 0x10, 0x03, 0x1a, 0x31, 0x88, 0xb5, 0x18,
-	0x03, 0x92, 0xb5, 0x18, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x9a, 0xb5, 0x18, 0x21, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x20, 0x63, 0x61, 0x6c, 0x6c,
-	0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x56, 0x46, 0x53, 0x12, 0x45,
-	0x0a, 0x0e, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x53,
-	0x10, 0x05, 0x1a, 0x31, 0x88, 0xb5, 0x18, 0x03, 0x92, 0xb5, 0x18, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x9a, 0xb5, 0x18, 0x21, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x72, 0x65,
-	0x61, 0x64, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68,
-	0x65, 0x20, 0x56, 0x46, 0x53, 0x12, 0x4b, 0x0a, 0x13, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45,
-	0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x53, 0x49, 0x5a, 0x45, 0x53, 0x10, 0x06, 0x1a, 0x32,
-	0x88, 0xb5, 0x18, 0x04, 0x9a, 0xb5, 0x18, 0x2a, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x72, 0x65, 0x61, 0x64, 0x20, 0x73, 0x69, 0x7a,
-	0x65, 0x73, 0x20, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x56,
-	0x46, 0x53, 0x12, 0x5b, 0x0a, 0x15, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x52,
-	0x45, 0x41, 0x44, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x07, 0x1a, 0x40, 0x88,
-	0xb5, 0x18, 0x04, 0x92, 0xb5, 0x18, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x92, 0xb5, 0x18, 0x0b, 0x72,
-	0x65, 0x63, 0x76, 0x5f, 0x6b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x9a, 0xb5, 0x18, 0x21, 0x4c, 0x61,
-	0x74, 0x65, 0x6e, 0x63, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x72, 0x65, 0x61, 0x64, 0x20, 0x52, 0x50,
-	0x43, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x56, 0x46, 0x53, 0x12,
-	0x62, 0x0a, 0x17, 0x56, 0x46, 0x53, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x5f, 0x43, 0x4f, 0x4e,
-	0x54, 0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x53, 0x10, 0x08, 0x1a, 0x45, 0x88, 0xb5,
-	0x18, 0x03, 0x92, 0xb5, 0x18, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x9a, 0xb5,
-	0x18, 0x31, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x6f, 0x70, 0x65, 0x6e,
-	0x73, 0x20, 0x6f, 0x6e, 0x20, 0x66, 0x69, 0x78, 0x65, 0x64, 0x2d, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20,
-	0x56, 0x46, 0x53, 0x12, 0x3c, 0x0a, 0x0c, 0x56, 0x46, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x44,
-	0x49, 0x52, 0x53, 0x10, 0x09, 0x1a, 0x2a, 0x88, 0xb5, 0x18, 0x03, 0x9a, 0xb5, 0x18, 0x22, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x72, 0x65, 0x61, 0x64, 0x64, 0x69, 0x72,
-	0x20, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x20, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x56, 0x46,
-	0x53, 0x12, 0x44, 0x0a, 0x13, 0x56, 0x46, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x44, 0x49, 0x52,
-	0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x0a, 0x1a, 0x2b, 0x88, 0xb5, 0x18, 0x04,
-	0x9a, 0xb5, 0x18, 0x23, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x72,
-	0x65, 0x61, 0x64, 0x64, 0x69, 0x72, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x20, 0x6f, 0x6e, 0x20,
-	0x74, 0x68, 0x65, 0x20, 0x56, 0x46, 0x53, 0x12, 0x60, 0x0a, 0x11, 0x56, 0x46, 0x53, 0x5f, 0x50,
-	0x45, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x44, 0x49, 0x52, 0x53, 0x10, 0x0b, 0x1a, 0x49,
-	0x88, 0xb5, 0x18, 0x03, 0x92, 0xb5, 0x18, 0x04, 0x70, 0x65, 0x65, 0x72, 0x92, 0xb5, 0x18, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x9a, 0xb5, 0x18, 0x31, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f,
-	0x66, 0x20, 0x72, 0x65, 0x61, 0x64, 0x64, 0x69, 0x72, 0x20, 0x52, 0x50, 0x43, 0x73, 0x20, 0x73,
-	0x65, 0x6e, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x70, 0x65, 0x65, 0x72, 0x73, 0x20, 0x66, 0x72, 0x6f,
-	0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x56, 0x46, 0x53, 0x12, 0x68, 0x0a, 0x18, 0x56, 0x46, 0x53,
-	0x5f, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x44, 0x49, 0x52, 0x5f, 0x4c, 0x41,
-	0x54, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x0c, 0x1a, 0x4a, 0x88, 0xb5, 0x18, 0x04, 0x92, 0xb5, 0x18,
-	0x04, 0x70, 0x65, 0x65, 0x72, 0x92, 0xb5, 0x18, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x9a, 0xb5, 0x18,
-	0x32, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x72, 0x65, 0x61, 0x64,
-	0x64, 0x69, 0x72, 0x20, 0x52, 0x50, 0x43, 0x73, 0x20, 0x73, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x6f,
-	0x20, 0x70, 0x65, 0x65, 0x72, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20,
-	0x56, 0x46, 0x53, 0x12, 0x48, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x48,
-	0x41, 0x53, 0x48, 0x45, 0x53, 0x10, 0x0d, 0x1a, 0x34, 0x88, 0xb5, 0x18, 0x03, 0x9a, 0xb5, 0x18,
-	0x2c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x73,
-	0x20, 0x77, 0x65, 0x27, 0x76, 0x65, 0x20, 0x68, 0x61, 0x73, 0x68, 0x65, 0x64, 0x20, 0x66, 0x6f,
-	0x72, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x12, 0x65, 0x0a,
-	0x11, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x50, 0x43, 0x53, 0x5f, 0x52, 0x45,
-	0x43, 0x56, 0x10, 0x0e, 0x1a, 0x4e, 0x88, 0xb5, 0x18, 0x03, 0x92, 0xb5, 0x18, 0x03, 0x72, 0x70,
-	0x63, 0x92, 0xb5, 0x18, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x92, 0xb5, 0x18, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x9a, 0xb5, 0x18, 0x2d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66,
-	0x20, 0x52, 0x50, 0x43, 0x73, 0x20, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x20, 0x62,
-	0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x20, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x12, 0x76, 0x0a, 0x19, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f,
-	0x52, 0x50, 0x43, 0x53, 0x5f, 0x52, 0x45, 0x43, 0x56, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43,
-	0x59, 0x10, 0x0f, 0x1a, 0x57, 0x88, 0xb5, 0x18, 0x04, 0x92, 0xb5, 0x18, 0x03, 0x72, 0x70, 0x63,
-	0x92, 0xb5, 0x18, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x92, 0xb5, 0x18, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x9a, 0xb5, 0x18, 0x36, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x20, 0x6f, 0x66,
-	0x20, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x20, 0x52, 0x50, 0x43, 0x73, 0x20, 0x68,
-	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x5b, 0x0a, 0x1c,
-	0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x52, 0x43, 0x48, 0x45, 0x53, 0x54, 0x52,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x10, 0x1a, 0x39,
-	0x88, 0xb5, 0x18, 0x03, 0x92, 0xb5, 0x18, 0x03, 0x77, 0x68, 0x79, 0x9a, 0xb5, 0x18, 0x2a, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x20, 0x77,
-	0x65, 0x20, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x20, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x63, 0x68,
-	0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x68, 0x0a, 0x21, 0x43, 0x4f, 0x4e,
-	0x54, 0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x52, 0x43, 0x48, 0x45, 0x53, 0x54, 0x52, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x11,
-	0x1a, 0x41, 0x88, 0xb5, 0x18, 0x03, 0x92, 0xb5, 0x18, 0x03, 0x77, 0x68, 0x79, 0x9a, 0xb5, 0x18,
-	0x32, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x20, 0x77, 0x65, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x6a, 0x6f,
-	0x69, 0x6e, 0x20, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x63, 0x0a, 0x13, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f,
-	0x52, 0x45, 0x43, 0x56, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0x12, 0x1a, 0x4a, 0x88, 0xb5,
-	0x18, 0x03, 0x92, 0xb5, 0x18, 0x04, 0x70, 0x65, 0x65, 0x72, 0x92, 0xb5, 0x18, 0x0d, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x9a, 0xb5, 0x18, 0x29, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x79, 0x74, 0x65, 0x73, 0x20, 0x72,
-	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x6f, 0x74, 0x68,
-	0x65, 0x72, 0x20, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x5d, 0x0a, 0x13, 0x54, 0x52, 0x41, 0x4e,
-	0x53, 0x46, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10,
-	0x13, 0x1a, 0x44, 0x88, 0xb5, 0x18, 0x03, 0x92, 0xb5, 0x18, 0x04, 0x70, 0x65, 0x65, 0x72, 0x92,
-	0xb5, 0x18, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x9a, 0xb5, 0x18, 0x23, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x20, 0x73, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x6f, 0x74, 0x68, 0x65,
-	0x72, 0x20, 0x70, 0x65, 0x65, 0x72, 0x73, 0x32, 0x64, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd1, 0x86, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1e, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x32, 0x48, 0x0a,
-	0x0d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x21,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0xd2, 0x86, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x32, 0x52, 0x0a, 0x12, 0x6d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0xd3, 0x86, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x15, 0x0a, 0x13, 0x50,
-	0x75, 0x73, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x2d, 0x0a, 0x0f, 0x50, 0x75, 0x73, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x22, 0x3e, 0x0a, 0x10, 0x50, 0x75, 0x73, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x73, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0f, 0x73, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x67,
-	0x73, 0x22, 0x24, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x2e, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x44,
-	0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x05, 0x66, 0x69,
-	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x46, 0x69, 0x6c, 0x65,
-	0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
-	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x75, 0x0a,
-	0x0f, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x64, 0x6e, 0x6f, 0x77, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x64, 0x6e, 0x6f, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x64,
-	0x61, 0x68, 0x65, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x64, 0x61,
-	0x68, 0x65, 0x61, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x49, 0x0a, 0x21, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x1e, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x4f, 0x72, 0x63, 0x68,
-	0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x64, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x22, 0x62, 0x0a, 0x13, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
-	0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x32, 0x9e, 0x03, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
-	0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x10, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x07,
-	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x0f, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x2e,
-	0x0a, 0x07, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x49, 0x50, 0x12, 0x0f, 0x2e, 0x47, 0x65, 0x74, 0x4d,
-	0x79, 0x49, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x47, 0x65, 0x74,
-	0x4d, 0x79, 0x49, 0x50, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46,
-	0x0a, 0x0f, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
-	0x74, 0x12, 0x17, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c,
-	0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0b, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x4f, 0x72, 0x63,
-	0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x0b, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x13, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x50, 0x75, 0x73,
-	0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x50, 0x75, 0x73, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x10,
-	0x2e, 0x50, 0x75, 0x73, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x11, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xba, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x52, 0x65, 0x61, 0x64,
-	0x44, 0x69, 0x72, 0x12, 0x0f, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x64,
-	0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x43, 0x0a,
-	0x0f, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x12, 0x14, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x14, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x28, 0x01,
-	0x30, 0x01, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x73, 0x67, 0x69, 0x65, 0x6c, 0x65, 0x6e, 0x2f, 0x72, 0x75, 0x66, 0x73, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x69, 0x6e, 0x67,
+	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x6e, 0x65, 0x77, 0x5f, 0x64,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01, 0x52, 0x15, 0x6e, 0x65, 0x77, 0x44, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22,
+	0x5e, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a,
+	0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12,
+	0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x5f, 0x47, 0x41, 0x55, 0x47, 0x45, 0x10, 0x01,
+	0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x47, 0x41, 0x55, 0x47, 0x45, 0x10, 0x02,
+	0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x10, 0x03, 0x12, 0x10, 0x0a,
+	0x0c, 0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x22,
+	0xa9, 0x0d, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x11, 0x0a, 0x07,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x1a, 0x04, 0x88, 0xb5, 0x18, 0x00, 0x12,
+	0x4d, 0x0a, 0x19, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f,
+	0x54, 0x49, 0x4d, 0x45, 0x5f, 0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x53, 0x10, 0x01, 0x1a, 0x2e,
+	0x88, 0xb5, 0x18, 0x02, 0x9a, 0xb5, 0x18, 0x26, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x20, 0x61, 0x74, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x56,
+	0x0a, 0x0e, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e,
+	0x10, 0x14, 0x1a, 0x42, 0x88, 0xb5, 0x18, 0x01, 0x92, 0xb5, 0x18, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x9a, 0xb5, 0x18, 0x2f, 0x41, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x20, 0x31, 0x2c,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x15, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
+	0x45, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10,
+	0x02, 0x1a, 0x28, 0x88, 0xb5, 0x18, 0x01, 0x9a, 0xb5, 0x18, 0x20, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x20, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x20, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x45, 0x0a, 0x0e, 0x54,
+	0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x53, 0x10, 0x03, 0x1a,
+	0x31, 0x88, 0xb5, 0x18, 0x03, 0x92, 0xb5, 0x18, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x9a, 0xb5, 0x18,
+	0x21, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x20,
+	0x63, 0x61, 0x6c, 0x6c, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x56,
+	0x46, 0x53, 0x12, 0x45, 0x0a, 0x0e, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x52,
+	0x45, 0x41, 0x44, 0x53, 0x10, 0x05, 0x1a, 0x31, 0x88, 0xb5, 0x18, 0x03, 0x92, 0xb5, 0x18, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x9a, 0xb5, 0x18, 0x21, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f,
+	0x66, 0x20, 0x72, 0x65, 0x61, 0x64, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x20, 0x66, 0x72, 0x6f,
+	0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x56, 0x46, 0x53, 0x12, 0x4b, 0x0a, 0x13, 0x54, 0x52, 0x41,
+	0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x53, 0x49, 0x5a, 0x45, 0x53,
+	0x10, 0x06, 0x1a, 0x32, 0x88, 0xb5, 0x18, 0x04, 0x9a, 0xb5, 0x18, 0x2a, 0x44, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x72, 0x65, 0x61, 0x64,
+	0x20, 0x73, 0x69, 0x7a, 0x65, 0x73, 0x20, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x56, 0x46, 0x53, 0x12, 0x5b, 0x0a, 0x15, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
+	0x45, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x10,
+	0x07, 0x1a, 0x40, 0x88, 0xb5, 0x18, 0x04, 0x92, 0xb5, 0x18, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x92,
+	0xb5, 0x18, 0x0b, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x6b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x9a, 0xb5,
+	0x18, 0x21, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x72, 0x65, 0x61,
+	0x64, 0x20, 0x52, 0x50, 0x43, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x56, 0x46, 0x53, 0x12, 0x62, 0x0a, 0x17, 0x56, 0x46, 0x53, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44,
+	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x53, 0x10, 0x08,
+	0x1a, 0x45, 0x88, 0xb5, 0x18, 0x03, 0x92, 0xb5, 0x18, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x9a, 0xb5, 0x18, 0x31, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20,
+	0x6f, 0x70, 0x65, 0x6e, 0x73, 0x20, 0x6f, 0x6e, 0x20, 0x66, 0x69, 0x78, 0x65, 0x64, 0x2d, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x20, 0x69, 0x6e, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x56, 0x46, 0x53, 0x12, 0x3c, 0x0a, 0x0c, 0x56, 0x46, 0x53, 0x5f, 0x52,
+	0x45, 0x41, 0x44, 0x44, 0x49, 0x52, 0x53, 0x10, 0x09, 0x1a, 0x2a, 0x88, 0xb5, 0x18, 0x03, 0x9a,
+	0xb5, 0x18, 0x22, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x72, 0x65, 0x61,
+	0x64, 0x64, 0x69, 0x72, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x20, 0x6f, 0x6e, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x56, 0x46, 0x53, 0x12, 0x44, 0x0a, 0x13, 0x56, 0x46, 0x53, 0x5f, 0x52, 0x45, 0x41,
+	0x44, 0x44, 0x49, 0x52, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x0a, 0x1a, 0x2b,
+	0x88, 0xb5, 0x18, 0x04, 0x9a, 0xb5, 0x18, 0x23, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x20,
+	0x6f, 0x66, 0x20, 0x72, 0x65, 0x61, 0x64, 0x64, 0x69, 0x72, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x73,
+	0x20, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x56, 0x46, 0x53, 0x12, 0x60, 0x0a, 0x11, 0x56,
+	0x46, 0x53, 0x5f, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x44, 0x49, 0x52, 0x53,
+	0x10, 0x0b, 0x1a, 0x49, 0x88, 0xb5, 0x18, 0x03, 0x92, 0xb5, 0x18, 0x04, 0x70, 0x65, 0x65, 0x72,
+	0x92, 0xb5, 0x18, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x9a, 0xb5, 0x18, 0x31, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x72, 0x65, 0x61, 0x64, 0x64, 0x69, 0x72, 0x20, 0x52, 0x50,
+	0x43, 0x73, 0x20, 0x73, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x70, 0x65, 0x65, 0x72, 0x73,
+	0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x56, 0x46, 0x53, 0x12, 0x68, 0x0a,
+	0x18, 0x56, 0x46, 0x53, 0x5f, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x44, 0x49,
+	0x52, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x0c, 0x1a, 0x4a, 0x88, 0xb5, 0x18,
+	0x04, 0x92, 0xb5, 0x18, 0x04, 0x70, 0x65, 0x65, 0x72, 0x92, 0xb5, 0x18, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x9a, 0xb5, 0x18, 0x32, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x20, 0x6f, 0x66, 0x20,
+	0x72, 0x65, 0x61, 0x64, 0x64, 0x69, 0x72, 0x20, 0x52, 0x50, 0x43, 0x73, 0x20, 0x73, 0x65, 0x6e,
+	0x74, 0x20, 0x74, 0x6f, 0x20, 0x70, 0x65, 0x65, 0x72, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x56, 0x46, 0x53, 0x12, 0x48, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x54, 0x45,
+	0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x45, 0x53, 0x10, 0x0d, 0x1a, 0x34, 0x88, 0xb5, 0x18,
+	0x03, 0x9a, 0xb5, 0x18, 0x2c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x20, 0x77, 0x65, 0x27, 0x76, 0x65, 0x20, 0x68, 0x61, 0x73, 0x68, 0x65,
+	0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x63, 0x69, 0x72, 0x63, 0x6c,
+	0x65, 0x12, 0x65, 0x0a, 0x11, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x50, 0x43,
+	0x53, 0x5f, 0x52, 0x45, 0x43, 0x56, 0x10, 0x0e, 0x1a, 0x4e, 0x88, 0xb5, 0x18, 0x03, 0x92, 0xb5,
+	0x18, 0x03, 0x72, 0x70, 0x63, 0x92, 0xb5, 0x18, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x92,
+	0xb5, 0x18, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x9a, 0xb5, 0x18, 0x2d, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x20, 0x6f, 0x66, 0x20, 0x52, 0x50, 0x43, 0x73, 0x20, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x76, 0x0a, 0x19, 0x43, 0x4f, 0x4e, 0x54,
+	0x45, 0x4e, 0x54, 0x5f, 0x52, 0x50, 0x43, 0x53, 0x5f, 0x52, 0x45, 0x43, 0x56, 0x5f, 0x4c, 0x41,
+	0x54, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x0f, 0x1a, 0x57, 0x88, 0xb5, 0x18, 0x04, 0x92, 0xb5, 0x18,
+	0x03, 0x72, 0x70, 0x63, 0x92, 0xb5, 0x18, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x92, 0xb5,
+	0x18, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x9a, 0xb5, 0x18, 0x36, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x20, 0x6f, 0x66, 0x20, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x20, 0x52, 0x50,
+	0x43, 0x73, 0x20, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x12, 0x5b, 0x0a, 0x1c, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x52, 0x43, 0x48,
+	0x45, 0x53, 0x54, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x45, 0x44,
+	0x10, 0x10, 0x1a, 0x39, 0x88, 0xb5, 0x18, 0x03, 0x92, 0xb5, 0x18, 0x03, 0x77, 0x68, 0x79, 0x9a,
+	0xb5, 0x18, 0x2a, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x20, 0x77, 0x65, 0x20, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x20, 0x61, 0x6e, 0x20,
+	0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x68, 0x0a,
+	0x21, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x52, 0x43, 0x48, 0x45, 0x53, 0x54,
+	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x10, 0x11, 0x1a, 0x41, 0x88, 0xb5, 0x18, 0x03, 0x92, 0xb5, 0x18, 0x03, 0x77, 0x68,
+	0x79, 0x9a, 0xb5, 0x18, 0x32, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x20, 0x77, 0x65, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x74,
+	0x6f, 0x20, 0x6a, 0x6f, 0x69, 0x6e, 0x20, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x63, 0x0a, 0x13, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x46, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x43, 0x56, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0x12,
+	0x1a, 0x4a, 0x88, 0xb5, 0x18, 0x03, 0x92, 0xb5, 0x18, 0x04, 0x70, 0x65, 0x65, 0x72, 0x92, 0xb5,
+	0x18, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x9a,
+	0xb5, 0x18, 0x29, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x20, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d,
+	0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x5d, 0x0a, 0x13,
+	0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x42, 0x59,
+	0x54, 0x45, 0x53, 0x10, 0x13, 0x1a, 0x44, 0x88, 0xb5, 0x18, 0x03, 0x92, 0xb5, 0x18, 0x04, 0x70,
+	0x65, 0x65, 0x72, 0x92, 0xb5, 0x18, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x9a, 0xb5, 0x18, 0x23, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f,
+	0x66, 0x20, 0x62, 0x79, 0x74, 0x65, 0x73, 0x20, 0x73, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x6f, 0x20,
+	0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x70, 0x65, 0x65, 0x72, 0x73, 0x32, 0x64, 0x0a, 0x0b, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75,
+	0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd1, 0x86,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70,
+	0x65, 0x32, 0x48, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd2, 0x86, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x32, 0x52, 0x0a, 0x12, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd3, 0x86, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x15, 0x0a, 0x13, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x0a, 0x0f, 0x50, 0x75, 0x73, 0x68, 0x4c, 0x6f,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x3e, 0x0a, 0x10, 0x50, 0x75, 0x73, 0x68, 0x4c, 0x6f, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x74, 0x6f,
+	0x70, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x4c, 0x6f, 0x67, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xfe, 0x01, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0xaf, 0x01, 0x0a, 0x06, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x27, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e,
+	0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x1b, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe9, 0x01, 0x0a, 0x1a, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x6f, 0x72, 0x63, 0x68,
+	0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72,
+	0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x6f, 0x72, 0x63,
+	0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x78, 0x0a, 0x0d, 0x4f,
+	0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0x27, 0x0a, 0x11, 0x4b, 0x69, 0x63, 0x6b, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x14,
+	0x0a, 0x12, 0x4b, 0x69, 0x63, 0x6b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x17, 0x45, 0x6e, 0x64, 0x4f, 0x72, 0x63, 0x68, 0x65,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64,
+	0x22, 0x1a, 0x0a, 0x18, 0x45, 0x6e, 0x64, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x05,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x34, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e,
+	0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x2f,
+	0x0a, 0x0f, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x06, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22,
+	0x12, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x13, 0x52, 0x65, 0x6c,
+	0x61, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x22, 0x86, 0x01, 0x0a, 0x09, 0x52, 0x65,
+	0x6c, 0x61, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x61, 0x6c, 0x5f,
+	0x70, 0x65, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x61, 0x6c,
+	0x50, 0x65, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x12, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61,
+	0x6e, 0x67, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x61, 0x6e, 0x67,
+	0x75, 0x70, 0x22, 0x24, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x2e, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64,
+	0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x05, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xac, 0x03, 0x0a, 0x04, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6e,
+	0x61, 0x6e, 0x6f, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x74, 0x69, 0x6d,
+	0x65, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x69, 0x72, 0x74, 0x68, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x69, 0x72, 0x74, 0x68,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x62,
+	0x69, 0x72, 0x74, 0x68, 0x74, 0x69, 0x6d, 0x65, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x29, 0x0a, 0x06, 0x78, 0x61, 0x74, 0x74, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x2e, 0x58, 0x61, 0x74, 0x74, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x78, 0x61, 0x74, 0x74, 0x72, 0x73, 0x1a, 0x39, 0x0a, 0x0b,
+	0x58, 0x61, 0x74, 0x74, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb2, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x64, 0x6e, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x72, 0x64, 0x6e, 0x6f, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x64, 0x61, 0x68, 0x65, 0x61, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x64, 0x61, 0x68, 0x65, 0x61, 0x64, 0x12,
+	0x3b, 0x0a, 0x12, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xb9, 0x01, 0x0a,
+	0x10, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a,
+	0x21, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x6f, 0x72, 0x63,
+	0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1e, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x54, 0x6f, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x64,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xcf, 0x01, 0x0a, 0x13, 0x50, 0x61, 0x73,
+	0x73, 0x69, 0x76, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a,
+	0x12, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x0d, 0x0a, 0x0b, 0x50, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x50, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x67, 0x0a, 0x07, 0x4e, 0x41, 0x54,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f,
+	0x4e, 0x41, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12,
+	0x0d, 0x0a, 0x09, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x0e,
+	0x0a, 0x0a, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x13,
+	0x0a, 0x0f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45,
+	0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x59, 0x4d, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43,
+	0x10, 0x05, 0x2a, 0x29, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53, 0x53, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x5a, 0x53, 0x54, 0x44, 0x10, 0x01, 0x32, 0xab, 0x04,
+	0x0a, 0x10, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x10,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x12, 0x0f, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x2e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4d, 0x79,
+	0x49, 0x50, 0x12, 0x0f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x49, 0x50, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x49, 0x50, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x17, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3e, 0x0a, 0x0b, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x12, 0x13,
+	0x2e, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12,
+	0x3a, 0x0a, 0x0b, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x13,
+	0x2e, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x50,
+	0x75, 0x73, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x10, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4c, 0x6f,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x50, 0x75, 0x73, 0x68,
+	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d,
+	0x0a, 0x0c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x12, 0x14,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x75,
+	0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a,
+	0x11, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x19, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x75, 0x6e, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x94, 0x03, 0x0a, 0x15,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4f, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72,
+	0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x4b, 0x69, 0x63, 0x6b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x12, 0x12, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x10, 0x45,
+	0x6e, 0x64, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x2e, 0x45, 0x6e, 0x64, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x45, 0x6e, 0x64, 0x4f,
+	0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x12, 0x12, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x31, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x10, 0x2e, 0x53, 0x65,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
+	0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x32, 0x70, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x12, 0x13, 0x2e, 0x52,
+	0x65, 0x6c, 0x61, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x27, 0x0a, 0x07, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x0a, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x44, 0x61,
+	0x74, 0x61, 0x1a, 0x0a, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00,
+	0x28, 0x01, 0x30, 0x01, 0x32, 0xe1, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x52, 0x65, 0x61, 0x64, 0x44,
+	0x69, 0x72, 0x12, 0x0f, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x64, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x10, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x0f,
+	0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12,
+	0x14, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x14, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x28, 0x01, 0x30,
+	0x01, 0x12, 0x25, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x0c, 0x2e, 0x50, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x67, 0x69, 0x65, 0x6c, 0x65, 0x6e, 0x2f, 0x72,
+	0x75, 0x66, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -2759,104 +4888,180 @@ func file_rufs_proto_rawDescGZIP() []byte {
 	return file_rufs_proto_rawDescData
 }
 
-var file_rufs_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_rufs_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_rufs_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_rufs_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
 var file_rufs_proto_goTypes = []interface{}{
-	(Endpoint_Type)(0),                                   // 0: Endpoint.Type
-	(PushMetricsRequest_MetricType)(0),                   // 1: PushMetricsRequest.MetricType
-	(PushMetricsRequest_MetricId)(0),                     // 2: PushMetricsRequest.MetricId
-	(*RegisterRequest)(nil),                              // 3: RegisterRequest
-	(*RegisterResponse)(nil),                             // 4: RegisterResponse
-	(*ConnectRequest)(nil),                               // 5: ConnectRequest
-	(*ConnectResponse)(nil),                              // 6: ConnectResponse
-	(*GetMyIPRequest)(nil),                               // 7: GetMyIPRequest
-	(*GetMyIPResponse)(nil),                              // 8: GetMyIPResponse
-	(*Endpoint)(nil),                                     // 9: Endpoint
-	(*Peer)(nil),                                         // 10: Peer
-	(*ResolveConflictRequest)(nil),                       // 11: ResolveConflictRequest
-	(*ResolveConflictResponse)(nil),                      // 12: ResolveConflictResponse
-	(*OrchestrateRequest)(nil),                           // 13: OrchestrateRequest
-	(*OrchestrateResponse)(nil),                          // 14: OrchestrateResponse
-	(*Range)(nil),                                        // 15: Range
-	(*PushMetricsRequest)(nil),                           // 16: PushMetricsRequest
-	(*PushMetricsResponse)(nil),                          // 17: PushMetricsResponse
-	(*PushLogsRequest)(nil),                              // 18: PushLogsRequest
-	(*PushLogsResponse)(nil),                             // 19: PushLogsResponse
-	(*ReadDirRequest)(nil),                               // 20: ReadDirRequest
-	(*ReadDirResponse)(nil),                              // 21: ReadDirResponse
-	(*File)(nil),                                         // 22: File
-	(*ReadFileRequest)(nil),                              // 23: ReadFileRequest
-	(*ReadFileResponse)(nil),                             // 24: ReadFileResponse
-	(*PassiveTransferData)(nil),                          // 25: PassiveTransferData
-	(*ConnectResponse_PeerList)(nil),                     // 26: ConnectResponse.PeerList
-	(*ConnectResponse_ActiveDownload)(nil),               // 27: ConnectResponse.ActiveDownload
-	(*ConnectResponse_ActiveDownloadList)(nil),           // 28: ConnectResponse.ActiveDownloadList
-	(*OrchestrateRequest_StartOrchestrationRequest)(nil), // 29: OrchestrateRequest.StartOrchestrationRequest
-	(*OrchestrateRequest_UpdateByteRanges)(nil),          // 30: OrchestrateRequest.UpdateByteRanges
-	(*OrchestrateRequest_ConnectedPeers)(nil),            // 31: OrchestrateRequest.ConnectedPeers
-	(*OrchestrateRequest_UploadFailed)(nil),              // 32: OrchestrateRequest.UploadFailed
-	(*OrchestrateRequest_SetHash)(nil),                   // 33: OrchestrateRequest.SetHash
-	(*OrchestrateRequest_HaveOpenHandles)(nil),           // 34: OrchestrateRequest.HaveOpenHandles
-	(*OrchestrateResponse_Welcome)(nil),                  // 35: OrchestrateResponse.Welcome
-	(*OrchestrateResponse_PeerList)(nil),                 // 36: OrchestrateResponse.PeerList
-	(*OrchestrateResponse_UploadCommand)(nil),            // 37: OrchestrateResponse.UploadCommand
-	(*PushMetricsRequest_Metric)(nil),                    // 38: PushMetricsRequest.Metric
-	(*descriptorpb.EnumValueOptions)(nil),                // 39: google.protobuf.EnumValueOptions
+	(NATType)(0),                                         // 0: NATType
+	(Compression)(0),                                     // 1: Compression
+	(Endpoint_Type)(0),                                   // 2: Endpoint.Type
+	(PushMetricsRequest_MetricType)(0),                   // 3: PushMetricsRequest.MetricType
+	(PushMetricsRequest_MetricId)(0),                     // 4: PushMetricsRequest.MetricId
+	(*RegisterRequest)(nil),                              // 5: RegisterRequest
+	(*RegisterResponse)(nil),                             // 6: RegisterResponse
+	(*ConnectRequest)(nil),                               // 7: ConnectRequest
+	(*ConnectResponse)(nil),                              // 8: ConnectResponse
+	(*PunchRequest)(nil),                                 // 9: PunchRequest
+	(*RequestPunchRequest)(nil),                          // 10: RequestPunchRequest
+	(*RequestPunchResponse)(nil),                         // 11: RequestPunchResponse
+	(*ReportPunchResultRequest)(nil),                     // 12: ReportPunchResultRequest
+	(*ReportPunchResultResponse)(nil),                    // 13: ReportPunchResultResponse
+	(*GetMyIPRequest)(nil),                               // 14: GetMyIPRequest
+	(*GetMyIPResponse)(nil),                              // 15: GetMyIPResponse
+	(*Endpoint)(nil),                                     // 16: Endpoint
+	(*Peer)(nil),                                         // 17: Peer
+	(*LanAnnouncement)(nil),                              // 18: LanAnnouncement
+	(*ResolveConflictRequest)(nil),                       // 19: ResolveConflictRequest
+	(*ResolveConflictResponse)(nil),                      // 20: ResolveConflictResponse
+	(*OrchestrateRequest)(nil),                           // 21: OrchestrateRequest
+	(*OrchestrateResponse)(nil),                          // 22: OrchestrateResponse
+	(*Range)(nil),                                        // 23: Range
+	(*PushMetricsRequest)(nil),                           // 24: PushMetricsRequest
+	(*PushMetricsResponse)(nil),                          // 25: PushMetricsResponse
+	(*PushLogsRequest)(nil),                              // 26: PushLogsRequest
+	(*PushLogsResponse)(nil),                             // 27: PushLogsResponse
+	(*ListClientsRequest)(nil),                           // 28: ListClientsRequest
+	(*ListClientsResponse)(nil),                          // 29: ListClientsResponse
+	(*ListOrchestrationsRequest)(nil),                    // 30: ListOrchestrationsRequest
+	(*ListOrchestrationsResponse)(nil),                   // 31: ListOrchestrationsResponse
+	(*KickClientRequest)(nil),                            // 32: KickClientRequest
+	(*KickClientResponse)(nil),                           // 33: KickClientResponse
+	(*EndOrchestrationRequest)(nil),                      // 34: EndOrchestrationRequest
+	(*EndOrchestrationResponse)(nil),                     // 35: EndOrchestrationResponse
+	(*Group)(nil),                                        // 36: Group
+	(*ListGroupsRequest)(nil),                            // 37: ListGroupsRequest
+	(*ListGroupsResponse)(nil),                           // 38: ListGroupsResponse
+	(*SetGroupRequest)(nil),                              // 39: SetGroupRequest
+	(*SetGroupResponse)(nil),                             // 40: SetGroupResponse
+	(*RelayListenRequest)(nil),                           // 41: RelayListenRequest
+	(*RelayListenResponse)(nil),                          // 42: RelayListenResponse
+	(*RelayData)(nil),                                    // 43: RelayData
+	(*ReadDirRequest)(nil),                               // 44: ReadDirRequest
+	(*ReadDirResponse)(nil),                              // 45: ReadDirResponse
+	(*File)(nil),                                         // 46: File
+	(*ReadFileRequest)(nil),                              // 47: ReadFileRequest
+	(*ReadFileResponse)(nil),                             // 48: ReadFileResponse
+	(*PassiveTransferData)(nil),                          // 49: PassiveTransferData
+	(*PingRequest)(nil),                                  // 50: PingRequest
+	(*PingResponse)(nil),                                 // 51: PingResponse
+	(*ConnectResponse_PeerList)(nil),                     // 52: ConnectResponse.PeerList
+	(*ConnectResponse_PeerListDelta)(nil),                // 53: ConnectResponse.PeerListDelta
+	(*ConnectResponse_ActiveDownload)(nil),               // 54: ConnectResponse.ActiveDownload
+	(*ConnectResponse_ActiveDownloadList)(nil),           // 55: ConnectResponse.ActiveDownloadList
+	(*ConnectResponse_DiscoveryAddresses)(nil),           // 56: ConnectResponse.DiscoveryAddresses
+	(*ConnectResponse_GroupList)(nil),                    // 57: ConnectResponse.GroupList
+	(*LanAnnouncement_Payload)(nil),                      // 58: LanAnnouncement.Payload
+	(*OrchestrateRequest_StartOrchestrationRequest)(nil), // 59: OrchestrateRequest.StartOrchestrationRequest
+	(*OrchestrateRequest_UpdateByteRanges)(nil),          // 60: OrchestrateRequest.UpdateByteRanges
+	(*OrchestrateRequest_ConnectedPeers)(nil),            // 61: OrchestrateRequest.ConnectedPeers
+	(*OrchestrateRequest_UploadFailed)(nil),              // 62: OrchestrateRequest.UploadFailed
+	(*OrchestrateRequest_SetHash)(nil),                   // 63: OrchestrateRequest.SetHash
+	(*OrchestrateRequest_HaveOpenHandles)(nil),           // 64: OrchestrateRequest.HaveOpenHandles
+	(*OrchestrateResponse_Welcome)(nil),                  // 65: OrchestrateResponse.Welcome
+	(*OrchestrateResponse_PeerList)(nil),                 // 66: OrchestrateResponse.PeerList
+	(*OrchestrateResponse_UploadCommand)(nil),            // 67: OrchestrateResponse.UploadCommand
+	(*PushMetricsRequest_Metric)(nil),                    // 68: PushMetricsRequest.Metric
+	(*ListClientsResponse_Client)(nil),                   // 69: ListClientsResponse.Client
+	(*ListOrchestrationsResponse_Orchestration)(nil),     // 70: ListOrchestrationsResponse.Orchestration
+	nil,                                   // 71: File.XattrsEntry
+	(*descriptorpb.EnumValueOptions)(nil), // 72: google.protobuf.EnumValueOptions
 }
 var file_rufs_proto_depIdxs = []int32{
-	9,  // 0: ConnectRequest.endpoints:type_name -> Endpoint
-	26, // 1: ConnectResponse.peer_list:type_name -> ConnectResponse.PeerList
-	28, // 2: ConnectResponse.active_downloads:type_name -> ConnectResponse.ActiveDownloadList
-	11, // 3: ConnectResponse.resolve_conflict_request:type_name -> ResolveConflictRequest
-	0,  // 4: Endpoint.type:type_name -> Endpoint.Type
-	9,  // 5: Peer.endpoints:type_name -> Endpoint
-	29, // 6: OrchestrateRequest.start_orchestration:type_name -> OrchestrateRequest.StartOrchestrationRequest
-	30, // 7: OrchestrateRequest.update_byte_ranges:type_name -> OrchestrateRequest.UpdateByteRanges
-	31, // 8: OrchestrateRequest.connected_peers:type_name -> OrchestrateRequest.ConnectedPeers
-	32, // 9: OrchestrateRequest.upload_failed:type_name -> OrchestrateRequest.UploadFailed
-	33, // 10: OrchestrateRequest.set_hash:type_name -> OrchestrateRequest.SetHash
-	34, // 11: OrchestrateRequest.have_open_handles:type_name -> OrchestrateRequest.HaveOpenHandles
-	35, // 12: OrchestrateResponse.welcome:type_name -> OrchestrateResponse.Welcome
-	36, // 13: OrchestrateResponse.peer_list:type_name -> OrchestrateResponse.PeerList
-	37, // 14: OrchestrateResponse.upload_command:type_name -> OrchestrateResponse.UploadCommand
-	38, // 15: PushMetricsRequest.metrics:type_name -> PushMetricsRequest.Metric
-	22, // 16: ReadDirResponse.files:type_name -> File
-	10, // 17: ConnectResponse.PeerList.peers:type_name -> Peer
-	27, // 18: ConnectResponse.ActiveDownloadList.active_downloads:type_name -> ConnectResponse.ActiveDownload
-	15, // 19: OrchestrateRequest.UpdateByteRanges.have:type_name -> Range
-	15, // 20: OrchestrateRequest.UpdateByteRanges.readnow:type_name -> Range
-	15, // 21: OrchestrateRequest.UpdateByteRanges.readahead:type_name -> Range
-	15, // 22: OrchestrateResponse.UploadCommand.range:type_name -> Range
-	2,  // 23: PushMetricsRequest.Metric.id:type_name -> PushMetricsRequest.MetricId
-	39, // 24: PushMetricsRequest.metric_type:extendee -> google.protobuf.EnumValueOptions
-	39, // 25: PushMetricsRequest.metric_fields:extendee -> google.protobuf.EnumValueOptions
-	39, // 26: PushMetricsRequest.metric_description:extendee -> google.protobuf.EnumValueOptions
-	1,  // 27: PushMetricsRequest.metric_type:type_name -> PushMetricsRequest.MetricType
-	3,  // 28: DiscoveryService.Register:input_type -> RegisterRequest
-	5,  // 29: DiscoveryService.Connect:input_type -> ConnectRequest
-	7,  // 30: DiscoveryService.GetMyIP:input_type -> GetMyIPRequest
-	11, // 31: DiscoveryService.ResolveConflict:input_type -> ResolveConflictRequest
-	13, // 32: DiscoveryService.Orchestrate:input_type -> OrchestrateRequest
-	16, // 33: DiscoveryService.PushMetrics:input_type -> PushMetricsRequest
-	18, // 34: DiscoveryService.PushLogs:input_type -> PushLogsRequest
-	20, // 35: ContentService.ReadDir:input_type -> ReadDirRequest
-	23, // 36: ContentService.ReadFile:input_type -> ReadFileRequest
-	25, // 37: ContentService.PassiveTransfer:input_type -> PassiveTransferData
-	4,  // 38: DiscoveryService.Register:output_type -> RegisterResponse
-	6,  // 39: DiscoveryService.Connect:output_type -> ConnectResponse
-	8,  // 40: DiscoveryService.GetMyIP:output_type -> GetMyIPResponse
-	12, // 41: DiscoveryService.ResolveConflict:output_type -> ResolveConflictResponse
-	14, // 42: DiscoveryService.Orchestrate:output_type -> OrchestrateResponse
-	17, // 43: DiscoveryService.PushMetrics:output_type -> PushMetricsResponse
-	19, // 44: DiscoveryService.PushLogs:output_type -> PushLogsResponse
-	21, // 45: ContentService.ReadDir:output_type -> ReadDirResponse
-	24, // 46: ContentService.ReadFile:output_type -> ReadFileResponse
-	25, // 47: ContentService.PassiveTransfer:output_type -> PassiveTransferData
-	38, // [38:48] is the sub-list for method output_type
-	28, // [28:38] is the sub-list for method input_type
-	27, // [27:28] is the sub-list for extension type_name
-	24, // [24:27] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	16, // 0: ConnectRequest.endpoints:type_name -> Endpoint
+	0,  // 1: ConnectRequest.nat_type:type_name -> NATType
+	52, // 2: ConnectResponse.peer_list:type_name -> ConnectResponse.PeerList
+	55, // 3: ConnectResponse.active_downloads:type_name -> ConnectResponse.ActiveDownloadList
+	19, // 4: ConnectResponse.resolve_conflict_request:type_name -> ResolveConflictRequest
+	53, // 5: ConnectResponse.peer_list_delta:type_name -> ConnectResponse.PeerListDelta
+	9,  // 6: ConnectResponse.punch_request:type_name -> PunchRequest
+	56, // 7: ConnectResponse.discovery_addresses:type_name -> ConnectResponse.DiscoveryAddresses
+	57, // 8: ConnectResponse.groups:type_name -> ConnectResponse.GroupList
+	16, // 9: PunchRequest.endpoints:type_name -> Endpoint
+	16, // 10: ReportPunchResultRequest.endpoint:type_name -> Endpoint
+	2,  // 11: Endpoint.type:type_name -> Endpoint.Type
+	16, // 12: Peer.endpoints:type_name -> Endpoint
+	0,  // 13: Peer.nat_type:type_name -> NATType
+	59, // 14: OrchestrateRequest.start_orchestration:type_name -> OrchestrateRequest.StartOrchestrationRequest
+	60, // 15: OrchestrateRequest.update_byte_ranges:type_name -> OrchestrateRequest.UpdateByteRanges
+	61, // 16: OrchestrateRequest.connected_peers:type_name -> OrchestrateRequest.ConnectedPeers
+	62, // 17: OrchestrateRequest.upload_failed:type_name -> OrchestrateRequest.UploadFailed
+	63, // 18: OrchestrateRequest.set_hash:type_name -> OrchestrateRequest.SetHash
+	64, // 19: OrchestrateRequest.have_open_handles:type_name -> OrchestrateRequest.HaveOpenHandles
+	65, // 20: OrchestrateResponse.welcome:type_name -> OrchestrateResponse.Welcome
+	66, // 21: OrchestrateResponse.peer_list:type_name -> OrchestrateResponse.PeerList
+	67, // 22: OrchestrateResponse.upload_command:type_name -> OrchestrateResponse.UploadCommand
+	68, // 23: PushMetricsRequest.metrics:type_name -> PushMetricsRequest.Metric
+	69, // 24: ListClientsResponse.clients:type_name -> ListClientsResponse.Client
+	70, // 25: ListOrchestrationsResponse.orchestrations:type_name -> ListOrchestrationsResponse.Orchestration
+	36, // 26: ListGroupsResponse.groups:type_name -> Group
+	36, // 27: SetGroupRequest.group:type_name -> Group
+	46, // 28: ReadDirResponse.files:type_name -> File
+	71, // 29: File.xattrs:type_name -> File.XattrsEntry
+	1,  // 30: ReadFileRequest.accept_compression:type_name -> Compression
+	1,  // 31: ReadFileResponse.compression:type_name -> Compression
+	1,  // 32: PassiveTransferData.compression:type_name -> Compression
+	1,  // 33: PassiveTransferData.accept_compression:type_name -> Compression
+	17, // 34: ConnectResponse.PeerList.peers:type_name -> Peer
+	17, // 35: ConnectResponse.PeerListDelta.updated_peers:type_name -> Peer
+	54, // 36: ConnectResponse.ActiveDownloadList.active_downloads:type_name -> ConnectResponse.ActiveDownload
+	36, // 37: ConnectResponse.GroupList.groups:type_name -> Group
+	16, // 38: LanAnnouncement.Payload.endpoints:type_name -> Endpoint
+	23, // 39: OrchestrateRequest.UpdateByteRanges.have:type_name -> Range
+	23, // 40: OrchestrateRequest.UpdateByteRanges.readnow:type_name -> Range
+	23, // 41: OrchestrateRequest.UpdateByteRanges.readahead:type_name -> Range
+	23, // 42: OrchestrateResponse.UploadCommand.range:type_name -> Range
+	4,  // 43: PushMetricsRequest.Metric.id:type_name -> PushMetricsRequest.MetricId
+	16, // 44: ListClientsResponse.Client.endpoints:type_name -> Endpoint
+	72, // 45: PushMetricsRequest.metric_type:extendee -> google.protobuf.EnumValueOptions
+	72, // 46: PushMetricsRequest.metric_fields:extendee -> google.protobuf.EnumValueOptions
+	72, // 47: PushMetricsRequest.metric_description:extendee -> google.protobuf.EnumValueOptions
+	3,  // 48: PushMetricsRequest.metric_type:type_name -> PushMetricsRequest.MetricType
+	5,  // 49: DiscoveryService.Register:input_type -> RegisterRequest
+	7,  // 50: DiscoveryService.Connect:input_type -> ConnectRequest
+	14, // 51: DiscoveryService.GetMyIP:input_type -> GetMyIPRequest
+	19, // 52: DiscoveryService.ResolveConflict:input_type -> ResolveConflictRequest
+	21, // 53: DiscoveryService.Orchestrate:input_type -> OrchestrateRequest
+	24, // 54: DiscoveryService.PushMetrics:input_type -> PushMetricsRequest
+	26, // 55: DiscoveryService.PushLogs:input_type -> PushLogsRequest
+	10, // 56: DiscoveryService.RequestPunch:input_type -> RequestPunchRequest
+	12, // 57: DiscoveryService.ReportPunchResult:input_type -> ReportPunchResultRequest
+	28, // 58: DiscoveryAdminService.ListClients:input_type -> ListClientsRequest
+	30, // 59: DiscoveryAdminService.ListOrchestrations:input_type -> ListOrchestrationsRequest
+	32, // 60: DiscoveryAdminService.KickClient:input_type -> KickClientRequest
+	34, // 61: DiscoveryAdminService.EndOrchestration:input_type -> EndOrchestrationRequest
+	37, // 62: DiscoveryAdminService.ListGroups:input_type -> ListGroupsRequest
+	39, // 63: DiscoveryAdminService.SetGroup:input_type -> SetGroupRequest
+	41, // 64: RelayService.Listen:input_type -> RelayListenRequest
+	43, // 65: RelayService.Connect:input_type -> RelayData
+	44, // 66: ContentService.ReadDir:input_type -> ReadDirRequest
+	47, // 67: ContentService.ReadFile:input_type -> ReadFileRequest
+	49, // 68: ContentService.PassiveTransfer:input_type -> PassiveTransferData
+	50, // 69: ContentService.Ping:input_type -> PingRequest
+	6,  // 70: DiscoveryService.Register:output_type -> RegisterResponse
+	8,  // 71: DiscoveryService.Connect:output_type -> ConnectResponse
+	15, // 72: DiscoveryService.GetMyIP:output_type -> GetMyIPResponse
+	20, // 73: DiscoveryService.ResolveConflict:output_type -> ResolveConflictResponse
+	22, // 74: DiscoveryService.Orchestrate:output_type -> OrchestrateResponse
+	25, // 75: DiscoveryService.PushMetrics:output_type -> PushMetricsResponse
+	27, // 76: DiscoveryService.PushLogs:output_type -> PushLogsResponse
+	11, // 77: DiscoveryService.RequestPunch:output_type -> RequestPunchResponse
+	13, // 78: DiscoveryService.ReportPunchResult:output_type -> ReportPunchResultResponse
+	29, // 79: DiscoveryAdminService.ListClients:output_type -> ListClientsResponse
+	31, // 80: DiscoveryAdminService.ListOrchestrations:output_type -> ListOrchestrationsResponse
+	33, // 81: DiscoveryAdminService.KickClient:output_type -> KickClientResponse
+	35, // 82: DiscoveryAdminService.EndOrchestration:output_type -> EndOrchestrationResponse
+	38, // 83: DiscoveryAdminService.ListGroups:output_type -> ListGroupsResponse
+	40, // 84: DiscoveryAdminService.SetGroup:output_type -> SetGroupResponse
+	42, // 85: RelayService.Listen:output_type -> RelayListenResponse
+	43, // 86: RelayService.Connect:output_type -> RelayData
+	45, // 87: ContentService.ReadDir:output_type -> ReadDirResponse
+	48, // 88: ContentService.ReadFile:output_type -> ReadFileResponse
+	49, // 89: ContentService.PassiveTransfer:output_type -> PassiveTransferData
+	51, // 90: ContentService.Ping:output_type -> PingResponse
+	70, // [70:91] is the sub-list for method output_type
+	49, // [49:70] is the sub-list for method input_type
+	48, // [48:49] is the sub-list for extension type_name
+	45, // [45:48] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
 }
 
 func init() { file_rufs_proto_init() }
@@ -2877,8 +5082,164 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterResponse); i {
+		file_rufs_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PunchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestPunchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestPunchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportPunchResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportPunchResultResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMyIPRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMyIPResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Endpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Peer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LanAnnouncement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveConflictRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2889,8 +5250,8 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConnectRequest); i {
+		file_rufs_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveConflictResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2901,8 +5262,8 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConnectResponse); i {
+		file_rufs_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrchestrateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2913,8 +5274,8 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMyIPRequest); i {
+		file_rufs_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrchestrateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2925,8 +5286,8 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMyIPResponse); i {
+		file_rufs_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Range); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2937,8 +5298,8 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Endpoint); i {
+		file_rufs_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushMetricsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2949,8 +5310,8 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Peer); i {
+		file_rufs_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushMetricsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2961,8 +5322,8 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResolveConflictRequest); i {
+		file_rufs_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushLogsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2973,8 +5334,8 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResolveConflictResponse); i {
+		file_rufs_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushLogsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2985,8 +5346,8 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrchestrateRequest); i {
+		file_rufs_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListClientsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2997,8 +5358,8 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrchestrateResponse); i {
+		file_rufs_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListClientsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3009,8 +5370,8 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Range); i {
+		file_rufs_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrchestrationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3021,8 +5382,8 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushMetricsRequest); i {
+		file_rufs_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrchestrationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3033,8 +5394,8 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushMetricsResponse); i {
+		file_rufs_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickClientRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3045,8 +5406,8 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushLogsRequest); i {
+		file_rufs_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickClientResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3057,8 +5418,8 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushLogsResponse); i {
+		file_rufs_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndOrchestrationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3069,7 +5430,115 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_rufs_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndOrchestrationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Group); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelayListenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelayListenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelayData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReadDirRequest); i {
 			case 0:
 				return &v.state
@@ -3081,7 +5550,7 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_rufs_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReadDirResponse); i {
 			case 0:
 				return &v.state
@@ -3093,7 +5562,7 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_rufs_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*File); i {
 			case 0:
 				return &v.state
@@ -3105,7 +5574,7 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_rufs_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReadFileRequest); i {
 			case 0:
 				return &v.state
@@ -3117,7 +5586,7 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_rufs_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReadFileResponse); i {
 			case 0:
 				return &v.state
@@ -3129,7 +5598,7 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_rufs_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PassiveTransferData); i {
 			case 0:
 				return &v.state
@@ -3141,7 +5610,31 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_rufs_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConnectResponse_PeerList); i {
 			case 0:
 				return &v.state
@@ -3153,7 +5646,19 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_rufs_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectResponse_PeerListDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConnectResponse_ActiveDownload); i {
 			case 0:
 				return &v.state
@@ -3165,7 +5670,7 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_rufs_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConnectResponse_ActiveDownloadList); i {
 			case 0:
 				return &v.state
@@ -3177,7 +5682,43 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_rufs_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectResponse_DiscoveryAddresses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectResponse_GroupList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LanAnnouncement_Payload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OrchestrateRequest_StartOrchestrationRequest); i {
 			case 0:
 				return &v.state
@@ -3189,7 +5730,7 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_rufs_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OrchestrateRequest_UpdateByteRanges); i {
 			case 0:
 				return &v.state
@@ -3201,7 +5742,7 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_rufs_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OrchestrateRequest_ConnectedPeers); i {
 			case 0:
 				return &v.state
@@ -3213,7 +5754,7 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_rufs_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OrchestrateRequest_UploadFailed); i {
 			case 0:
 				return &v.state
@@ -3225,7 +5766,7 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_rufs_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OrchestrateRequest_SetHash); i {
 			case 0:
 				return &v.state
@@ -3237,7 +5778,7 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_rufs_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OrchestrateRequest_HaveOpenHandles); i {
 			case 0:
 				return &v.state
@@ -3249,7 +5790,7 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_rufs_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OrchestrateResponse_Welcome); i {
 			case 0:
 				return &v.state
@@ -3261,7 +5802,7 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_rufs_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OrchestrateResponse_PeerList); i {
 			case 0:
 				return &v.state
@@ -3273,7 +5814,7 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_rufs_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OrchestrateResponse_UploadCommand); i {
 			case 0:
 				return &v.state
@@ -3285,7 +5826,7 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
-		file_rufs_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_rufs_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushMetricsRequest_Metric); i {
 			case 0:
 				return &v.state
@@ -3297,13 +5838,41 @@ func file_rufs_proto_init() {
 				return nil
 			}
 		}
+		file_rufs_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListClientsResponse_Client); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rufs_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrchestrationsResponse_Orchestration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_rufs_proto_msgTypes[3].OneofWrappers = []interface{}{
 		(*ConnectResponse_PeerList_)(nil),
 		(*ConnectResponse_ActiveDownloads)(nil),
 		(*ConnectResponse_ResolveConflictRequest)(nil),
+		(*ConnectResponse_PeerListDelta_)(nil),
+		(*ConnectResponse_PunchRequest)(nil),
+		(*ConnectResponse_DiscoveryAddresses_)(nil),
+		(*ConnectResponse_Groups)(nil),
 	}
-	file_rufs_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_rufs_proto_msgTypes[16].OneofWrappers = []interface{}{
 		(*OrchestrateRequest_StartOrchestration)(nil),
 		(*OrchestrateRequest_UpdateByteRanges_)(nil),
 		(*OrchestrateRequest_ConnectedPeers_)(nil),
@@ -3311,7 +5880,7 @@ func file_rufs_proto_init() {
 		(*OrchestrateRequest_SetHash_)(nil),
 		(*OrchestrateRequest_HaveOpenHandles_)(nil),
 	}
-	file_rufs_proto_msgTypes[11].OneofWrappers = []interface{}{
+	file_rufs_proto_msgTypes[17].OneofWrappers = []interface{}{
 		(*OrchestrateResponse_Welcome_)(nil),
 		(*OrchestrateResponse_PeerList_)(nil),
 		(*OrchestrateResponse_UploadCommand_)(nil),
@@ -3321,10 +5890,10 @@ func file_rufs_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rufs_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   36,
+			NumEnums:      5,
+			NumMessages:   67,
 			NumExtensions: 3,
-			NumServices:   2,
+			NumServices:   4,
 		},
 		GoTypes:           file_rufs_proto_goTypes,
 		DependencyIndexes: file_rufs_proto_depIdxs,
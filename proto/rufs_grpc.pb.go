@@ -26,6 +26,11 @@ type DiscoveryServiceClient interface {
 	Orchestrate(ctx context.Context, opts ...grpc.CallOption) (DiscoveryService_OrchestrateClient, error)
 	PushMetrics(ctx context.Context, in *PushMetricsRequest, opts ...grpc.CallOption) (*PushMetricsResponse, error)
 	PushLogs(ctx context.Context, in *PushLogsRequest, opts ...grpc.CallOption) (*PushLogsResponse, error)
+	// RequestPunch asks the discovery server to have us and another peer
+	// punch holes to each other's UDP endpoints at the same time.
+	RequestPunch(ctx context.Context, in *RequestPunchRequest, opts ...grpc.CallOption) (*RequestPunchResponse, error)
+	// ReportPunchResult tells the discovery server whether punching worked.
+	ReportPunchResult(ctx context.Context, in *ReportPunchResultRequest, opts ...grpc.CallOption) (*ReportPunchResultResponse, error)
 }
 
 type discoveryServiceClient struct {
@@ -144,6 +149,24 @@ func (c *discoveryServiceClient) PushLogs(ctx context.Context, in *PushLogsReque
 	return out, nil
 }
 
+func (c *discoveryServiceClient) RequestPunch(ctx context.Context, in *RequestPunchRequest, opts ...grpc.CallOption) (*RequestPunchResponse, error) {
+	out := new(RequestPunchResponse)
+	err := c.cc.Invoke(ctx, "/DiscoveryService/RequestPunch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryServiceClient) ReportPunchResult(ctx context.Context, in *ReportPunchResultRequest, opts ...grpc.CallOption) (*ReportPunchResultResponse, error) {
+	out := new(ReportPunchResultResponse)
+	err := c.cc.Invoke(ctx, "/DiscoveryService/ReportPunchResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DiscoveryServiceServer is the server API for DiscoveryService service.
 // All implementations must embed UnimplementedDiscoveryServiceServer
 // for forward compatibility
@@ -156,6 +179,11 @@ type DiscoveryServiceServer interface {
 	Orchestrate(DiscoveryService_OrchestrateServer) error
 	PushMetrics(context.Context, *PushMetricsRequest) (*PushMetricsResponse, error)
 	PushLogs(context.Context, *PushLogsRequest) (*PushLogsResponse, error)
+	// RequestPunch asks the discovery server to have us and another peer
+	// punch holes to each other's UDP endpoints at the same time.
+	RequestPunch(context.Context, *RequestPunchRequest) (*RequestPunchResponse, error)
+	// ReportPunchResult tells the discovery server whether punching worked.
+	ReportPunchResult(context.Context, *ReportPunchResultRequest) (*ReportPunchResultResponse, error)
 	mustEmbedUnimplementedDiscoveryServiceServer()
 }
 
@@ -184,6 +212,12 @@ func (UnimplementedDiscoveryServiceServer) PushMetrics(context.Context, *PushMet
 func (UnimplementedDiscoveryServiceServer) PushLogs(context.Context, *PushLogsRequest) (*PushLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PushLogs not implemented")
 }
+func (UnimplementedDiscoveryServiceServer) RequestPunch(context.Context, *RequestPunchRequest) (*RequestPunchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPunch not implemented")
+}
+func (UnimplementedDiscoveryServiceServer) ReportPunchResult(context.Context, *ReportPunchResultRequest) (*ReportPunchResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportPunchResult not implemented")
+}
 func (UnimplementedDiscoveryServiceServer) mustEmbedUnimplementedDiscoveryServiceServer() {}
 
 // UnsafeDiscoveryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -334,6 +368,42 @@ func _DiscoveryService_PushLogs_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DiscoveryService_RequestPunch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPunchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryServiceServer).RequestPunch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DiscoveryService/RequestPunch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryServiceServer).RequestPunch(ctx, req.(*RequestPunchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryService_ReportPunchResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportPunchResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryServiceServer).ReportPunchResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DiscoveryService/ReportPunchResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryServiceServer).ReportPunchResult(ctx, req.(*ReportPunchResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DiscoveryService_ServiceDesc is the grpc.ServiceDesc for DiscoveryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -361,6 +431,14 @@ var DiscoveryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PushLogs",
 			Handler:    _DiscoveryService_PushLogs_Handler,
 		},
+		{
+			MethodName: "RequestPunch",
+			Handler:    _DiscoveryService_RequestPunch_Handler,
+		},
+		{
+			MethodName: "ReportPunchResult",
+			Handler:    _DiscoveryService_ReportPunchResult_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -378,6 +456,475 @@ var DiscoveryService_ServiceDesc = grpc.ServiceDesc{
 	Metadata: "rufs.proto",
 }
 
+// DiscoveryAdminServiceClient is the client API for DiscoveryAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DiscoveryAdminServiceClient interface {
+	ListClients(ctx context.Context, in *ListClientsRequest, opts ...grpc.CallOption) (*ListClientsResponse, error)
+	ListOrchestrations(ctx context.Context, in *ListOrchestrationsRequest, opts ...grpc.CallOption) (*ListOrchestrationsResponse, error)
+	// KickClient closes the Connect stream of a client. The client will
+	// reconnect unless it was shut down in the meantime.
+	KickClient(ctx context.Context, in *KickClientRequest, opts ...grpc.CallOption) (*KickClientResponse, error)
+	EndOrchestration(ctx context.Context, in *EndOrchestrationRequest, opts ...grpc.CallOption) (*EndOrchestrationResponse, error)
+	ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error)
+	// SetGroup replaces the members of a group. A group without members is
+	// deleted. Connected clients are sent the new groups.
+	SetGroup(ctx context.Context, in *SetGroupRequest, opts ...grpc.CallOption) (*SetGroupResponse, error)
+}
+
+type discoveryAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDiscoveryAdminServiceClient(cc grpc.ClientConnInterface) DiscoveryAdminServiceClient {
+	return &discoveryAdminServiceClient{cc}
+}
+
+func (c *discoveryAdminServiceClient) ListClients(ctx context.Context, in *ListClientsRequest, opts ...grpc.CallOption) (*ListClientsResponse, error) {
+	out := new(ListClientsResponse)
+	err := c.cc.Invoke(ctx, "/DiscoveryAdminService/ListClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryAdminServiceClient) ListOrchestrations(ctx context.Context, in *ListOrchestrationsRequest, opts ...grpc.CallOption) (*ListOrchestrationsResponse, error) {
+	out := new(ListOrchestrationsResponse)
+	err := c.cc.Invoke(ctx, "/DiscoveryAdminService/ListOrchestrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryAdminServiceClient) KickClient(ctx context.Context, in *KickClientRequest, opts ...grpc.CallOption) (*KickClientResponse, error) {
+	out := new(KickClientResponse)
+	err := c.cc.Invoke(ctx, "/DiscoveryAdminService/KickClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryAdminServiceClient) EndOrchestration(ctx context.Context, in *EndOrchestrationRequest, opts ...grpc.CallOption) (*EndOrchestrationResponse, error) {
+	out := new(EndOrchestrationResponse)
+	err := c.cc.Invoke(ctx, "/DiscoveryAdminService/EndOrchestration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryAdminServiceClient) ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error) {
+	out := new(ListGroupsResponse)
+	err := c.cc.Invoke(ctx, "/DiscoveryAdminService/ListGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryAdminServiceClient) SetGroup(ctx context.Context, in *SetGroupRequest, opts ...grpc.CallOption) (*SetGroupResponse, error) {
+	out := new(SetGroupResponse)
+	err := c.cc.Invoke(ctx, "/DiscoveryAdminService/SetGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DiscoveryAdminServiceServer is the server API for DiscoveryAdminService service.
+// All implementations must embed UnimplementedDiscoveryAdminServiceServer
+// for forward compatibility
+type DiscoveryAdminServiceServer interface {
+	ListClients(context.Context, *ListClientsRequest) (*ListClientsResponse, error)
+	ListOrchestrations(context.Context, *ListOrchestrationsRequest) (*ListOrchestrationsResponse, error)
+	// KickClient closes the Connect stream of a client. The client will
+	// reconnect unless it was shut down in the meantime.
+	KickClient(context.Context, *KickClientRequest) (*KickClientResponse, error)
+	EndOrchestration(context.Context, *EndOrchestrationRequest) (*EndOrchestrationResponse, error)
+	ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error)
+	// SetGroup replaces the members of a group. A group without members is
+	// deleted. Connected clients are sent the new groups.
+	SetGroup(context.Context, *SetGroupRequest) (*SetGroupResponse, error)
+	mustEmbedUnimplementedDiscoveryAdminServiceServer()
+}
+
+// UnimplementedDiscoveryAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDiscoveryAdminServiceServer struct {
+}
+
+func (UnimplementedDiscoveryAdminServiceServer) ListClients(context.Context, *ListClientsRequest) (*ListClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClients not implemented")
+}
+func (UnimplementedDiscoveryAdminServiceServer) ListOrchestrations(context.Context, *ListOrchestrationsRequest) (*ListOrchestrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrchestrations not implemented")
+}
+func (UnimplementedDiscoveryAdminServiceServer) KickClient(context.Context, *KickClientRequest) (*KickClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickClient not implemented")
+}
+func (UnimplementedDiscoveryAdminServiceServer) EndOrchestration(context.Context, *EndOrchestrationRequest) (*EndOrchestrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndOrchestration not implemented")
+}
+func (UnimplementedDiscoveryAdminServiceServer) ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroups not implemented")
+}
+func (UnimplementedDiscoveryAdminServiceServer) SetGroup(context.Context, *SetGroupRequest) (*SetGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroup not implemented")
+}
+func (UnimplementedDiscoveryAdminServiceServer) mustEmbedUnimplementedDiscoveryAdminServiceServer() {}
+
+// UnsafeDiscoveryAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DiscoveryAdminServiceServer will
+// result in compilation errors.
+type UnsafeDiscoveryAdminServiceServer interface {
+	mustEmbedUnimplementedDiscoveryAdminServiceServer()
+}
+
+func RegisterDiscoveryAdminServiceServer(s grpc.ServiceRegistrar, srv DiscoveryAdminServiceServer) {
+	s.RegisterService(&DiscoveryAdminService_ServiceDesc, srv)
+}
+
+func _DiscoveryAdminService_ListClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryAdminServiceServer).ListClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DiscoveryAdminService/ListClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryAdminServiceServer).ListClients(ctx, req.(*ListClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryAdminService_ListOrchestrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrchestrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryAdminServiceServer).ListOrchestrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DiscoveryAdminService/ListOrchestrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryAdminServiceServer).ListOrchestrations(ctx, req.(*ListOrchestrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryAdminService_KickClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryAdminServiceServer).KickClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DiscoveryAdminService/KickClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryAdminServiceServer).KickClient(ctx, req.(*KickClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryAdminService_EndOrchestration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndOrchestrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryAdminServiceServer).EndOrchestration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DiscoveryAdminService/EndOrchestration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryAdminServiceServer).EndOrchestration(ctx, req.(*EndOrchestrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryAdminService_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryAdminServiceServer).ListGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DiscoveryAdminService/ListGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryAdminServiceServer).ListGroups(ctx, req.(*ListGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryAdminService_SetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryAdminServiceServer).SetGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DiscoveryAdminService/SetGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryAdminServiceServer).SetGroup(ctx, req.(*SetGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DiscoveryAdminService_ServiceDesc is the grpc.ServiceDesc for DiscoveryAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DiscoveryAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "DiscoveryAdminService",
+	HandlerType: (*DiscoveryAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListClients",
+			Handler:    _DiscoveryAdminService_ListClients_Handler,
+		},
+		{
+			MethodName: "ListOrchestrations",
+			Handler:    _DiscoveryAdminService_ListOrchestrations_Handler,
+		},
+		{
+			MethodName: "KickClient",
+			Handler:    _DiscoveryAdminService_KickClient_Handler,
+		},
+		{
+			MethodName: "EndOrchestration",
+			Handler:    _DiscoveryAdminService_EndOrchestration_Handler,
+		},
+		{
+			MethodName: "ListGroups",
+			Handler:    _DiscoveryAdminService_ListGroups_Handler,
+		},
+		{
+			MethodName: "SetGroup",
+			Handler:    _DiscoveryAdminService_SetGroup_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rufs.proto",
+}
+
+// RelayServiceClient is the client API for RelayService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RelayServiceClient interface {
+	// Listen makes the caller reachable through this relay. A message is sent
+	// for each peer that wants to connect, after which the caller should call
+	// Connect with accept_connection_id set.
+	Listen(ctx context.Context, in *RelayListenRequest, opts ...grpc.CallOption) (RelayService_ListenClient, error)
+	// Connect is used to both dial and accept relayed connections. The first
+	// message must have either dial_peer or accept_connection_id set. The relay
+	// sends an empty message once the connection is established, after which
+	// data is forwarded in both directions.
+	Connect(ctx context.Context, opts ...grpc.CallOption) (RelayService_ConnectClient, error)
+}
+
+type relayServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRelayServiceClient(cc grpc.ClientConnInterface) RelayServiceClient {
+	return &relayServiceClient{cc}
+}
+
+func (c *relayServiceClient) Listen(ctx context.Context, in *RelayListenRequest, opts ...grpc.CallOption) (RelayService_ListenClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RelayService_ServiceDesc.Streams[0], "/RelayService/Listen", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &relayServiceListenClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RelayService_ListenClient interface {
+	Recv() (*RelayListenResponse, error)
+	grpc.ClientStream
+}
+
+type relayServiceListenClient struct {
+	grpc.ClientStream
+}
+
+func (x *relayServiceListenClient) Recv() (*RelayListenResponse, error) {
+	m := new(RelayListenResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *relayServiceClient) Connect(ctx context.Context, opts ...grpc.CallOption) (RelayService_ConnectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RelayService_ServiceDesc.Streams[1], "/RelayService/Connect", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &relayServiceConnectClient{stream}
+	return x, nil
+}
+
+type RelayService_ConnectClient interface {
+	Send(*RelayData) error
+	Recv() (*RelayData, error)
+	grpc.ClientStream
+}
+
+type relayServiceConnectClient struct {
+	grpc.ClientStream
+}
+
+func (x *relayServiceConnectClient) Send(m *RelayData) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *relayServiceConnectClient) Recv() (*RelayData, error) {
+	m := new(RelayData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RelayServiceServer is the server API for RelayService service.
+// All implementations must embed UnimplementedRelayServiceServer
+// for forward compatibility
+type RelayServiceServer interface {
+	// Listen makes the caller reachable through this relay. A message is sent
+	// for each peer that wants to connect, after which the caller should call
+	// Connect with accept_connection_id set.
+	Listen(*RelayListenRequest, RelayService_ListenServer) error
+	// Connect is used to both dial and accept relayed connections. The first
+	// message must have either dial_peer or accept_connection_id set. The relay
+	// sends an empty message once the connection is established, after which
+	// data is forwarded in both directions.
+	Connect(RelayService_ConnectServer) error
+	mustEmbedUnimplementedRelayServiceServer()
+}
+
+// UnimplementedRelayServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRelayServiceServer struct {
+}
+
+func (UnimplementedRelayServiceServer) Listen(*RelayListenRequest, RelayService_ListenServer) error {
+	return status.Errorf(codes.Unimplemented, "method Listen not implemented")
+}
+func (UnimplementedRelayServiceServer) Connect(RelayService_ConnectServer) error {
+	return status.Errorf(codes.Unimplemented, "method Connect not implemented")
+}
+func (UnimplementedRelayServiceServer) mustEmbedUnimplementedRelayServiceServer() {}
+
+// UnsafeRelayServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RelayServiceServer will
+// result in compilation errors.
+type UnsafeRelayServiceServer interface {
+	mustEmbedUnimplementedRelayServiceServer()
+}
+
+func RegisterRelayServiceServer(s grpc.ServiceRegistrar, srv RelayServiceServer) {
+	s.RegisterService(&RelayService_ServiceDesc, srv)
+}
+
+func _RelayService_Listen_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RelayListenRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RelayServiceServer).Listen(m, &relayServiceListenServer{stream})
+}
+
+type RelayService_ListenServer interface {
+	Send(*RelayListenResponse) error
+	grpc.ServerStream
+}
+
+type relayServiceListenServer struct {
+	grpc.ServerStream
+}
+
+func (x *relayServiceListenServer) Send(m *RelayListenResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RelayService_Connect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RelayServiceServer).Connect(&relayServiceConnectServer{stream})
+}
+
+type RelayService_ConnectServer interface {
+	Send(*RelayData) error
+	Recv() (*RelayData, error)
+	grpc.ServerStream
+}
+
+type relayServiceConnectServer struct {
+	grpc.ServerStream
+}
+
+func (x *relayServiceConnectServer) Send(m *RelayData) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *relayServiceConnectServer) Recv() (*RelayData, error) {
+	m := new(RelayData)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RelayService_ServiceDesc is the grpc.ServiceDesc for RelayService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RelayService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "RelayService",
+	HandlerType: (*RelayServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Listen",
+			Handler:       _RelayService_Listen_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Connect",
+			Handler:       _RelayService_Connect_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "rufs.proto",
+}
+
 // ContentServiceClient is the client API for ContentService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
@@ -385,6 +932,8 @@ type ContentServiceClient interface {
 	ReadDir(ctx context.Context, in *ReadDirRequest, opts ...grpc.CallOption) (*ReadDirResponse, error)
 	ReadFile(ctx context.Context, in *ReadFileRequest, opts ...grpc.CallOption) (ContentService_ReadFileClient, error)
 	PassiveTransfer(ctx context.Context, opts ...grpc.CallOption) (ContentService_PassiveTransferClient, error)
+	// Ping is used to measure the round trip time and packet loss of each path to a peer.
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
 }
 
 type contentServiceClient struct {
@@ -467,6 +1016,15 @@ func (x *contentServicePassiveTransferClient) Recv() (*PassiveTransferData, erro
 	return m, nil
 }
 
+func (c *contentServiceClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	out := new(PingResponse)
+	err := c.cc.Invoke(ctx, "/ContentService/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ContentServiceServer is the server API for ContentService service.
 // All implementations must embed UnimplementedContentServiceServer
 // for forward compatibility
@@ -474,6 +1032,8 @@ type ContentServiceServer interface {
 	ReadDir(context.Context, *ReadDirRequest) (*ReadDirResponse, error)
 	ReadFile(*ReadFileRequest, ContentService_ReadFileServer) error
 	PassiveTransfer(ContentService_PassiveTransferServer) error
+	// Ping is used to measure the round trip time and packet loss of each path to a peer.
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
 	mustEmbedUnimplementedContentServiceServer()
 }
 
@@ -490,6 +1050,9 @@ func (UnimplementedContentServiceServer) ReadFile(*ReadFileRequest, ContentServi
 func (UnimplementedContentServiceServer) PassiveTransfer(ContentService_PassiveTransferServer) error {
 	return status.Errorf(codes.Unimplemented, "method PassiveTransfer not implemented")
 }
+func (UnimplementedContentServiceServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
 func (UnimplementedContentServiceServer) mustEmbedUnimplementedContentServiceServer() {}
 
 // UnsafeContentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -568,6 +1131,24 @@ func (x *contentServicePassiveTransferServer) Recv() (*PassiveTransferData, erro
 	return m, nil
 }
 
+func _ContentService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ContentService/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ContentService_ServiceDesc is the grpc.ServiceDesc for ContentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -579,6 +1160,10 @@ var ContentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReadDir",
 			Handler:    _ContentService_ReadDir_Handler,
 		},
+		{
+			MethodName: "Ping",
+			Handler:    _ContentService_Ping_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
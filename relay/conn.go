@@ -0,0 +1,184 @@
+package relay
+
+import (
+	"context"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sgielen/rufs/client/connectivity/udptransport/deadlinech"
+	pb "github.com/sgielen/rufs/proto"
+)
+
+// maxChunkSize is the maximum number of bytes sent in a single RelayData message.
+const maxChunkSize = 32 * 1024
+
+type relayStream interface {
+	Send(*pb.RelayData) error
+	Recv() (*pb.RelayData, error)
+}
+
+// Dial connects to peer through the relay. ctx is only used for setting up the connection.
+func Dial(ctx context.Context, client pb.RelayServiceClient, peer string) (net.Conn, error) {
+	return connect(ctx, client, peer, &pb.RelayData{DialPeer: peer})
+}
+
+// Accept accepts a connection that was announced by Listen.
+func Accept(ctx context.Context, client pb.RelayServiceClient, announcement *pb.RelayListenResponse) (net.Conn, error) {
+	return connect(ctx, client, announcement.GetPeer(), &pb.RelayData{AcceptConnectionId: announcement.GetConnectionId()})
+}
+
+func connect(ctx context.Context, client pb.RelayServiceClient, peer string, first *pb.RelayData) (net.Conn, error) {
+	// The connection outlives ctx.
+	sctx, cancel := context.WithCancel(context.Background())
+	stream, err := client.Connect(sctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if err := stream.Send(first); err != nil {
+		cancel()
+		return nil, err
+	}
+	// Wait for the relay to tell us the connection is established.
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := stream.Recv()
+		errCh <- err
+	}()
+	select {
+	case err := <-errCh:
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+	case <-ctx.Done():
+		cancel()
+		return nil, ctx.Err()
+	}
+	c := &conn{
+		ctx:          sctx,
+		cancel:       cancel,
+		stream:       stream,
+		peer:         peer,
+		recv:         make(chan []byte),
+		readDeadline: deadlinech.New(),
+	}
+	go c.reader()
+	return c, nil
+}
+
+// conn is a net.Conn over a RelayService.Connect stream.
+type conn struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	stream relayStream
+	peer   string
+
+	recv         chan []byte
+	recvErr      error
+	buf          []byte
+	readDeadline *deadlinech.DeadlineChannel
+
+	writeMtx sync.Mutex
+}
+
+func (c *conn) reader() {
+	defer close(c.recv)
+	for {
+		msg, err := c.stream.Recv()
+		if err != nil {
+			c.recvErr = err
+			return
+		}
+		if msg.GetHangup() {
+			// The relay keeps its side of the stream open until we close ours.
+			c.recvErr = io.EOF
+			c.cancel()
+			return
+		}
+		if len(msg.GetData()) == 0 {
+			continue
+		}
+		select {
+		case c.recv <- msg.GetData():
+		case <-c.ctx.Done():
+			c.recvErr = io.EOF
+			return
+		}
+	}
+}
+
+func (c *conn) Read(p []byte) (int, error) {
+	if len(c.buf) == 0 {
+		select {
+		case data, ok := <-c.recv:
+			if !ok {
+				return 0, c.recvErr
+			}
+			c.buf = data
+		case <-c.readDeadline.Wait():
+			return 0, os.ErrDeadlineExceeded
+		}
+	}
+	n := copy(p, c.buf)
+	c.buf = c.buf[n:]
+	return n, nil
+}
+
+func (c *conn) Write(p []byte) (int, error) {
+	c.writeMtx.Lock()
+	defer c.writeMtx.Unlock()
+	written := 0
+	for len(p) > 0 {
+		n := len(p)
+		if n > maxChunkSize {
+			n = maxChunkSize
+		}
+		if err := c.stream.Send(&pb.RelayData{Data: p[:n]}); err != nil {
+			return written, err
+		}
+		written += n
+		p = p[n:]
+	}
+	return written, nil
+}
+
+func (c *conn) Close() error {
+	c.cancel()
+	return nil
+}
+
+func (c *conn) LocalAddr() net.Addr {
+	return relayAddr("local")
+}
+
+func (c *conn) RemoteAddr() net.Addr {
+	return relayAddr(c.peer)
+}
+
+func (c *conn) SetDeadline(t time.Time) error {
+	return c.SetReadDeadline(t)
+}
+
+func (c *conn) SetReadDeadline(t time.Time) error {
+	c.readDeadline.Set(t)
+	return nil
+}
+
+// SetWriteDeadline is not supported; writes are only blocked by gRPC flow control.
+func (c *conn) SetWriteDeadline(t time.Time) error {
+	return nil
+}
+
+type relayAddr string
+
+func (relayAddr) Network() string {
+	return "relay"
+}
+
+func (a relayAddr) String() string {
+	return string(a)
+}
@@ -0,0 +1,188 @@
+// Package relay forwards connections between peers that can't reach each other directly.
+package relay
+
+import (
+	"io"
+	"log"
+	"math/rand"
+	"sync"
+	"time"
+
+	pb "github.com/sgielen/rufs/proto"
+	"github.com/sgielen/rufs/security"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// acceptTimeout is how long we wait for a listening peer to accept a connection.
+const acceptTimeout = 10 * time.Second
+
+// Server is the RelayService. It can be registered on any gRPC server that authenticates peers with their circle
+// certificate.
+type Server struct {
+	pb.UnimplementedRelayServiceServer
+
+	mtx       sync.Mutex
+	listeners map[string]chan *pb.RelayListenResponse
+	pending   map[int64]*pendingConn
+}
+
+type pendingConn struct {
+	target   string
+	accepted chan pb.RelayService_ConnectServer
+	done     chan struct{}
+}
+
+func NewServer() *Server {
+	return &Server{
+		listeners: map[string]chan *pb.RelayListenResponse{},
+		pending:   map[int64]*pendingConn{},
+	}
+}
+
+func (s *Server) Listen(req *pb.RelayListenRequest, stream pb.RelayService_ListenServer) error {
+	peer, _, err := security.PeerFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	ch := make(chan *pb.RelayListenResponse, 10)
+	s.mtx.Lock()
+	s.listeners[peer] = ch
+	s.mtx.Unlock()
+	defer func() {
+		s.mtx.Lock()
+		if s.listeners[peer] == ch {
+			delete(s.listeners, peer)
+		}
+		s.mtx.Unlock()
+	}()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case msg := <-ch:
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *Server) Connect(stream pb.RelayService_ConnectServer) error {
+	peer, _, err := security.PeerFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	msg, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	switch {
+	case msg.GetDialPeer() != "":
+		return s.dial(peer, msg.GetDialPeer(), stream)
+	case msg.GetAcceptConnectionId() != 0:
+		return s.accept(peer, msg.GetAcceptConnectionId(), stream)
+	default:
+		return status.Error(codes.InvalidArgument, "first message should have dial_peer or accept_connection_id set")
+	}
+}
+
+func (s *Server) dial(from, to string, stream pb.RelayService_ConnectServer) error {
+	pc := &pendingConn{
+		target:   to,
+		accepted: make(chan pb.RelayService_ConnectServer, 1),
+		done:     make(chan struct{}),
+	}
+	defer close(pc.done)
+	s.mtx.Lock()
+	ch, ok := s.listeners[to]
+	if !ok {
+		s.mtx.Unlock()
+		return status.Errorf(codes.Unavailable, "%s is not reachable through this relay", to)
+	}
+	id := rand.Int63()
+	s.pending[id] = pc
+	s.mtx.Unlock()
+	defer func() {
+		s.mtx.Lock()
+		delete(s.pending, id)
+		s.mtx.Unlock()
+	}()
+
+	select {
+	case ch <- &pb.RelayListenResponse{ConnectionId: id, Peer: from}:
+	default:
+		return status.Errorf(codes.ResourceExhausted, "%s has too many pending relayed connections", to)
+	}
+
+	var other pb.RelayService_ConnectServer
+	select {
+	case other = <-pc.accepted:
+	case <-time.After(acceptTimeout):
+		return status.Errorf(codes.DeadlineExceeded, "%s didn't accept the relayed connection", to)
+	case <-stream.Context().Done():
+		return stream.Context().Err()
+	}
+
+	if err := stream.Send(&pb.RelayData{}); err != nil {
+		return err
+	}
+	if err := other.Send(&pb.RelayData{}); err != nil {
+		return err
+	}
+	log.Printf("Relaying connection from %s to %s", from, to)
+	err := splice(stream, other)
+	log.Printf("Relayed connection from %s to %s closed: %v", from, to, err)
+	return err
+}
+
+func (s *Server) accept(peer string, id int64, stream pb.RelayService_ConnectServer) error {
+	s.mtx.Lock()
+	pc, ok := s.pending[id]
+	if ok && pc.target == peer {
+		delete(s.pending, id)
+	}
+	s.mtx.Unlock()
+	if !ok || pc.target != peer {
+		return status.Errorf(codes.NotFound, "no pending connection %d for you", id)
+	}
+	pc.accepted <- stream
+	// The dialing side is splicing the streams together, and returns once it no longer uses our stream. Returning
+	// before that would close our stream under its feet, even if our client went away.
+	<-pc.done
+	return nil
+}
+
+// splice forwards data between a and b until either side stops. It only returns once neither stream is used anymore.
+func splice(a, b pb.RelayService_ConnectServer) error {
+	var g errgroup.Group
+	g.Go(func() error {
+		return pipe(a, b)
+	})
+	g.Go(func() error {
+		return pipe(b, a)
+	})
+	return g.Wait()
+}
+
+// pipe forwards data from one stream to the other. When from stops, it tells the other side to hang up, which ends the
+// pipe in the other direction.
+func pipe(from, to pb.RelayService_ConnectServer) error {
+	defer to.Send(&pb.RelayData{Hangup: true})
+	for {
+		msg, err := from.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(msg.GetData()) == 0 {
+			continue
+		}
+		if err := to.Send(&pb.RelayData{Data: msg.GetData()}); err != nil {
+			return err
+		}
+	}
+}
@@ -3,6 +3,7 @@ package security
 
 import (
 	"context"
+	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha1"
@@ -25,6 +26,9 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// adminOrganizationalUnit is set on certificates that are allowed to use the DiscoveryAdminService.
+const adminOrganizationalUnit = "RUFS admin"
+
 // CAKeyPair holds a CA certificate and private key.
 type CAKeyPair struct {
 	ca   *x509.Certificate
@@ -57,12 +61,25 @@ func (p *CAKeyPair) Name() string {
 
 // Sign a given public key with this CA and create a certificate for $name.
 func (p *CAKeyPair) Sign(pubKey []byte, name string) ([]byte, error) {
+	return p.sign(pubKey, name, false)
+}
+
+// SignAdmin signs a given public key with this CA and creates an admin certificate for $name.
+// Admin certificates can be used to call the DiscoveryAdminService.
+func (p *CAKeyPair) SignAdmin(pubKey []byte, name string) ([]byte, error) {
+	return p.sign(pubKey, name, true)
+}
+
+func (p *CAKeyPair) sign(pubKey []byte, name string, admin bool) ([]byte, error) {
 	pk, err := x509.ParsePKIXPublicKey(pubKey)
 	if err != nil {
 		return nil, err
 	}
 
 	t := createCertTemplate(false, name)
+	if admin {
+		t.Subject.OrganizationalUnit = []string{adminOrganizationalUnit}
+	}
 	cert, err := x509.CreateCertificate(rand.Reader, t, p.ca, pk, p.priv)
 	if err != nil {
 		return nil, err
@@ -317,6 +334,47 @@ func (p *KeyPair) CommonName() string {
 	return p.crt.Leaf.Subject.CommonName
 }
 
+// CircleName returns the CommonName of the CA that signed this key pair.
+func (p *KeyPair) CircleName() string {
+	return p.ca.Subject.CommonName
+}
+
+// SignMessage signs data with our private key. It returns our certificate and the signature, which peers can check
+// with VerifyMessage.
+func (p *KeyPair) SignMessage(data []byte) (certificate, signature []byte, err error) {
+	signer, ok := p.crt.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, nil, errors.New("private key can't be used for signing")
+	}
+	h := sha256.Sum256(data)
+	sig, err := signer.Sign(rand.Reader, h[:], crypto.SHA256)
+	if err != nil {
+		return nil, nil, err
+	}
+	return p.crt.Certificate[0], sig, nil
+}
+
+// VerifyMessage checks that data was signed with the key of the given certificate, and that the certificate was
+// issued by our CA. It returns the CommonName of the signer.
+func (p *KeyPair) VerifyMessage(data, certificate, signature []byte) (string, error) {
+	c, err := x509.ParseCertificate(certificate)
+	if err != nil {
+		return "", err
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(p.ca)
+	if _, err := c.Verify(x509.VerifyOptions{
+		Roots:     roots,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return "", err
+	}
+	if err := c.CheckSignature(x509.SHA256WithRSA, data, signature); err != nil {
+		return "", err
+	}
+	return c.Subject.CommonName, nil
+}
+
 func (p *KeyPair) TLSConfigForMasterClient() *tls.Config {
 	return getTlsConfig(tlsConfigMasterClient, p.ca, &p.crt, p.ca.Subject.CommonName)
 }
@@ -340,20 +398,70 @@ func (p *KeyPair) TLSConfigForServerClient(name string) *tls.Config {
 	return getTlsConfig(tlsConfigServerClient, p.ca, &p.crt, name)
 }
 
+// TLSConfigForQUIC returns the TLS config for QUIC connections between peers, for both the dialing and accepting
+// side. It only checks that the other side is in our circle: when dialing we don't know the name of the peer, and gRPC
+// verifies who the peer is inside the QUIC connection anyway.
+func (p *KeyPair) TLSConfigForQUIC() *tls.Config {
+	CAs := x509.NewCertPool()
+	CAs.AddCert(p.ca)
+	return &tls.Config{
+		ClientCAs:          CAs,
+		Certificates:       []tls.Certificate{p.crt},
+		ClientAuth:         tls.RequireAndVerifyClientCert,
+		MinVersion:         tls.VersionTLS13,
+		InsecureSkipVerify: true, // We'll do the checking ourselves in VerifyPeerCertificate.
+		VerifyPeerCertificate: func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return errors.New("no certificate given")
+			}
+			c, err := x509.ParseCertificate(rawCerts[0])
+			if err != nil {
+				return err
+			}
+			_, err = c.Verify(x509.VerifyOptions{
+				Roots:     CAs,
+				KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+			})
+			return err
+		},
+	}
+}
+
 // PeerFromContext can be called from inside an RPC handler to get the remote peer and circle name.
 func PeerFromContext(ctx context.Context) (name string, circle string, err error) {
+	c, err := certificateFromContext(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	return c.Subject.CommonName, c.Issuer.CommonName, nil
+}
+
+// AdminFromContext is like PeerFromContext, but fails unless the remote peer presented an admin certificate.
+func AdminFromContext(ctx context.Context) (name string, circle string, err error) {
+	c, err := certificateFromContext(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	for _, ou := range c.Subject.OrganizationalUnit {
+		if ou == adminOrganizationalUnit {
+			return c.Subject.CommonName, c.Issuer.CommonName, nil
+		}
+	}
+	return "", "", status.Errorf(codes.PermissionDenied, "%s is not an administrator", c.Subject.CommonName)
+}
+
+func certificateFromContext(ctx context.Context) (*x509.Certificate, error) {
 	p, ok := peer.FromContext(ctx)
 	if !ok {
 		// This should never happen.
-		return "", "", status.Error(codes.Unauthenticated, "no Peer attached to context; TLS issue?")
+		return nil, status.Error(codes.Unauthenticated, "no Peer attached to context; TLS issue?")
 	}
 	ti, ok := p.AuthInfo.(credentials.TLSInfo)
 	if !ok {
-		return "", "", status.Error(codes.Unauthenticated, "couldn't get TLSInfo; TLS issue?")
+		return nil, status.Error(codes.Unauthenticated, "couldn't get TLSInfo; TLS issue?")
 	}
 	if len(ti.State.PeerCertificates) == 0 {
-		return "", "", status.Error(codes.Unauthenticated, "no client certificate given")
+		return nil, status.Error(codes.Unauthenticated, "no client certificate given")
 	}
-	c := ti.State.PeerCertificates[0]
-	return c.Subject.CommonName, c.Issuer.CommonName, nil
+	return ti.State.PeerCertificates[0], nil
 }
@@ -0,0 +1,64 @@
+// Package stunlite describes the requests the stunlite server of the discovery server understands.
+//
+// A client sends a UDP packet to the stunlite server, which replies with the address (as "ip:port") it received the
+// packet from. An empty packet is treated as an Echo request. The other requests are used to find out what kind of NAT
+// the client is behind.
+package stunlite
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Echo asks for a reply from the address the request was sent to.
+	Echo byte = 1
+	// ChangePort asks for a reply from the alternate port of the server.
+	ChangePort byte = 2
+	// ChangeAddress asks for a reply from the alternate address of the server. Servers without one don't reply.
+	ChangeAddress byte = 3
+	// Info asks for the alternate port and address of the server. The reply is made by FormatInfo.
+	Info byte = 4
+)
+
+const infoPrefix = "rufs-stun "
+
+// ErrUnsupported is returned by ParseInfo if the server doesn't understand Info requests.
+var ErrUnsupported = errors.New("stunlite server doesn't support NAT type detection")
+
+// FormatInfo returns the reply to an Info request. altAddr is the "ip:port" replies to ChangeAddress are sent from,
+// or empty if the server doesn't have one.
+func FormatInfo(altPort int, altAddr string) []byte {
+	if altAddr == "" {
+		altAddr = "-"
+	}
+	return []byte(fmt.Sprintf("%s%d %s", infoPrefix, altPort, altAddr))
+}
+
+// IsInfo returns whether b looks like a reply to an Info request rather than an address.
+func IsInfo(b []byte) bool {
+	return strings.HasPrefix(string(b), infoPrefix)
+}
+
+// ParseInfo parses the reply to an Info request. Servers that don't understand Info reply with the client's address,
+// which results in ErrUnsupported.
+func ParseInfo(b []byte) (altPort int, altAddr string, err error) {
+	if !IsInfo(b) {
+		return 0, "", ErrUnsupported
+	}
+	s := string(b)
+	f := strings.Fields(strings.TrimPrefix(s, infoPrefix))
+	if len(f) != 2 {
+		return 0, "", fmt.Errorf("malformed stunlite info %q", s)
+	}
+	altPort, err = strconv.Atoi(f[0])
+	if err != nil {
+		return 0, "", fmt.Errorf("malformed stunlite info %q: %v", s, err)
+	}
+	if f[1] != "-" {
+		altAddr = f[1]
+	}
+	return altPort, altAddr, nil
+}
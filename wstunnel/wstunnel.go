@@ -0,0 +1,171 @@
+// Package wstunnel carries connections over WebSockets, for networks that only allow HTTP(S). The connections inside
+// the tunnel are still authenticated and encrypted with the circle certificates.
+package wstunnel
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Path is where tunnels are served.
+const Path = "/rufs-tunnel"
+
+var upgrader = websocket.Upgrader{
+	// Browsers aren't involved, and peers authenticate each other inside the tunnel.
+	CheckOrigin: func(*http.Request) bool { return true },
+}
+
+// Dial opens a tunnel to url (ws:// or wss://). It connects with dial if it's not nil, and otherwise uses the proxy
+// from the environment like other HTTP clients.
+func Dial(ctx context.Context, url string, dial func(ctx context.Context, network, addr string) (net.Conn, error)) (net.Conn, error) {
+	d := websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: 30 * time.Second,
+	}
+	if dial != nil {
+		d.Proxy = nil
+		d.NetDialContext = dial
+	}
+	ws, resp, err := d.DialContext(ctx, url, nil)
+	if resp != nil {
+		resp.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &conn{ws: ws}, nil
+}
+
+// Listener is an http.Handler that accepts tunnels, and a net.Listener that returns them.
+type Listener struct {
+	addr      net.Addr
+	conns     chan net.Conn
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// NewListener returns a Listener. addr is only used as its Addr().
+func NewListener(addr net.Addr) *Listener {
+	return &Listener{
+		addr:   addr,
+		conns:  make(chan net.Conn),
+		closed: make(chan struct{}),
+	}
+}
+
+func (l *Listener) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ws, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade already replied with an error.
+		return
+	}
+	select {
+	case l.conns <- &conn{ws: ws}:
+	case <-l.closed:
+		ws.Close()
+	}
+}
+
+func (l *Listener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.conns:
+		return c, nil
+	case <-l.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *Listener) Close() error {
+	l.closeOnce.Do(func() {
+		close(l.closed)
+	})
+	return nil
+}
+
+func (l *Listener) Addr() net.Addr {
+	return l.addr
+}
+
+// conn is a net.Conn that sends its data as binary WebSocket messages.
+type conn struct {
+	ws *websocket.Conn
+
+	readMtx sync.Mutex
+	reader  io.Reader
+
+	writeMtx sync.Mutex
+}
+
+func (c *conn) Read(p []byte) (int, error) {
+	c.readMtx.Lock()
+	defer c.readMtx.Unlock()
+	for {
+		if c.reader == nil {
+			typ, r, err := c.ws.NextReader()
+			if err != nil {
+				if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+					return 0, io.EOF
+				}
+				return 0, err
+			}
+			if typ != websocket.BinaryMessage {
+				continue
+			}
+			c.reader = r
+		}
+		n, err := c.reader.Read(p)
+		if errors.Is(err, io.EOF) {
+			c.reader = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+func (c *conn) Write(p []byte) (int, error) {
+	c.writeMtx.Lock()
+	defer c.writeMtx.Unlock()
+	if err := c.ws.WriteMessage(websocket.BinaryMessage, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (c *conn) Close() error {
+	msg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	_ = c.ws.WriteControl(websocket.CloseMessage, msg, time.Now().Add(time.Second))
+	return c.ws.Close()
+}
+
+func (c *conn) LocalAddr() net.Addr {
+	return c.ws.LocalAddr()
+}
+
+func (c *conn) RemoteAddr() net.Addr {
+	return c.ws.RemoteAddr()
+}
+
+func (c *conn) SetDeadline(t time.Time) error {
+	if err := c.ws.SetReadDeadline(t); err != nil {
+		return err
+	}
+	return c.ws.SetWriteDeadline(t)
+}
+
+func (c *conn) SetReadDeadline(t time.Time) error {
+	return c.ws.SetReadDeadline(t)
+}
+
+func (c *conn) SetWriteDeadline(t time.Time) error {
+	return c.ws.SetWriteDeadline(t)
+}